@@ -31,5 +31,7 @@ func main() {
 		log.Println("graceful shutdown failed")
 	}
 
+	app.Scheduler.Stop()
+
 	log.Println("shutdown complete")
 }
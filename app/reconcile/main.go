@@ -0,0 +1,37 @@
+package main
+
+import (
+	"log"
+
+	canteenrepository "github.com/SyafaHadyan/freepass-2026/internal/app/canteen/repository"
+	canteenusecase "github.com/SyafaHadyan/freepass-2026/internal/app/canteen/usecase"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/db"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/payment"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/redis"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/storage"
+)
+
+func main() {
+	config := env.New()
+
+	if config.PaymentProvider == "fake" {
+		log.Panic("payment reconciliation cannot run against the fake payment provider")
+	}
+
+	database := db.New(config)
+
+	redis := redis.New(config)
+
+	payment := payment.NewMidtrans(config)
+
+	storage := storage.New(config, nil)
+
+	canteenRepository := canteenrepository.NewCanteenDB(database)
+	canteenUseCase := canteenusecase.NewCanteenUseCase(canteenRepository, payment, storage, config, redis)
+
+	err := canteenUseCase.ReconcilePayments()
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -2,10 +2,21 @@
 package rest
 
 import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/SyafaHadyan/freepass-2026/internal/app/canteen/repository"
 	"github.com/SyafaHadyan/freepass-2026/internal/app/canteen/usecase"
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/orderstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentmethod"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/settlement"
 	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
 	"github.com/SyafaHadyan/freepass-2026/internal/middleware"
 	"github.com/go-playground/validator/v10"
@@ -37,19 +48,56 @@ func NewCanteenHandler(
 
 	routerGroup.Post("", middleware.Authentication, middleware.Canteen, canteenHandler.CreateCanteen)
 	routerGroup.Post("/menu", middleware.Authentication, middleware.Canteen, canteenHandler.CreateMenu)
+	routerGroup.Post("/menu/category", middleware.Authentication, middleware.Canteen, canteenHandler.CreateMenuCategory)
 	routerGroup.Post("/menu/order", middleware.Authentication, canteenHandler.CreateOrder)
 	routerGroup.Post("/payment", middleware.Authentication, canteenHandler.CreatePayment)
+	routerGroup.Post("/payment/topup", middleware.Authentication, canteenHandler.TopUpWallet)
 	routerGroup.Post("/payment/verification", canteenHandler.VerifyPayment)
+	routerGroup.Post("/payment/notification/:id/replay", middleware.Authentication, middleware.Admin, canteenHandler.ReplayPaymentNotification)
+	routerGroup.Post("/payment/refund", middleware.Authentication, middleware.AdminOrCanteen, canteenHandler.RefundOrder)
+	routerGroup.Post("/settlement/payout", middleware.Authentication, middleware.Admin, canteenHandler.GeneratePayouts)
 	routerGroup.Post("/menu/order/feedback", middleware.Authentication, canteenHandler.CreateFeedback)
+	routerGroup.Post("/voucher", middleware.Authentication, middleware.AdminOrCanteen, canteenHandler.CreateVoucher)
+	routerGroup.Post("/menu/:id/promotion", middleware.Authentication, middleware.Canteen, canteenHandler.CreatePromotion)
+	routerGroup.Post("/menu/:id/modifier", middleware.Authentication, middleware.Canteen, canteenHandler.CreateModifierGroup)
+	routerGroup.Post("/menu/:id/availability", middleware.Authentication, middleware.Canteen, canteenHandler.CreateMenuAvailability)
+	routerGroup.Patch("/:id", middleware.Authentication, middleware.Canteen, canteenHandler.UpdateCanteen)
+	routerGroup.Patch("/menu/stock", middleware.Authentication, middleware.Canteen, canteenHandler.RestockMenu)
 	routerGroup.Patch("/menu/:id", middleware.Authentication, middleware.Canteen, canteenHandler.UpdateMenu)
+	routerGroup.Patch("/menu/category/:id", middleware.Authentication, middleware.Canteen, canteenHandler.UpdateMenuCategory)
+	routerGroup.Patch("/menu/modifier/option/:id", middleware.Authentication, middleware.Canteen, canteenHandler.UpdateModifierOption)
 	routerGroup.Patch("/menu/order/:id", middleware.Authentication, middleware.Canteen, canteenHandler.UpdateOrder)
+	routerGroup.Patch("/menu/order/:id/reject", middleware.Authentication, middleware.Canteen, canteenHandler.RejectOrder)
+	routerGroup.Patch("/menu/order/:id/cash", middleware.Authentication, middleware.Canteen, canteenHandler.ConfirmCashPayment)
+	routerGroup.Patch("/settlement/payout/:id/paid", middleware.Authentication, middleware.Admin, canteenHandler.MarkPayoutPaid)
 	routerGroup.Get("", middleware.Authentication, canteenHandler.GetCanteenList)
+	routerGroup.Get("/voucher", middleware.Authentication, middleware.AdminOrCanteen, canteenHandler.GetVoucherList)
 	routerGroup.Get("/:id", middleware.Authentication, canteenHandler.GetCanteenInfo)
+	routerGroup.Get("/:id/menu", middleware.Authentication, canteenHandler.GetMenuList)
+	routerGroup.Get("/:id/menu/grouped", middleware.Authentication, canteenHandler.GetCanteenMenu)
+	routerGroup.Get("/payment/notification", middleware.Authentication, middleware.Admin, canteenHandler.GetPaymentNotificationList)
+	routerGroup.Get("/payment/refund", middleware.Authentication, canteenHandler.GetRefundList)
+	routerGroup.Get("/payment/reconciliation", middleware.Authentication, middleware.Admin, canteenHandler.GetReconciliationReportList)
+	routerGroup.Get("/payment/reconciliation/:id", middleware.Authentication, middleware.Admin, canteenHandler.GetReconciliationReport)
+	routerGroup.Get("/settlement/payout", middleware.Authentication, middleware.AdminOrCanteen, canteenHandler.GetPayoutList)
+	routerGroup.Get("/settlement/payout/:id", middleware.Authentication, middleware.AdminOrCanteen, canteenHandler.GetPayout)
+	routerGroup.Get("/menu/order", middleware.Authentication, middleware.Canteen, canteenHandler.GetOrderList)
+	routerGroup.Get("/menu/category", middleware.Authentication, canteenHandler.GetMenuCategoryList)
 	routerGroup.Get("/menu/:id", middleware.Authentication, canteenHandler.GetMenuInfo)
+	routerGroup.Get("/menu/:id/promotion", middleware.Authentication, canteenHandler.GetPromotionList)
+	routerGroup.Get("/menu/:id/modifier", middleware.Authentication, canteenHandler.GetModifierGroupList)
+	routerGroup.Get("/menu/:id/availability", middleware.Authentication, canteenHandler.GetMenuAvailabilityList)
 	routerGroup.Get("/menu/order/:id", middleware.Authentication, canteenHandler.GetOrderInfo)
+	routerGroup.Get("/menu/order/:id/receipt", middleware.Authentication, canteenHandler.GetReceipt)
 	routerGroup.Get("/menu/order/feedback/:id", middleware.Authentication, canteenHandler.GetFeeback)
 	routerGroup.Delete("/menu/:id", middleware.Authentication, middleware.Canteen, canteenHandler.SoftDeleteMenu)
+	routerGroup.Delete("/menu/promotion/:id", middleware.Authentication, middleware.Canteen, canteenHandler.SoftDeletePromotion)
+	routerGroup.Delete("/menu/modifier/:id", middleware.Authentication, middleware.Canteen, canteenHandler.SoftDeleteModifierGroup)
+	routerGroup.Delete("/menu/availability/:id", middleware.Authentication, middleware.Canteen, canteenHandler.SoftDeleteMenuAvailability)
+	routerGroup.Delete("/menu/category/:id", middleware.Authentication, middleware.Canteen, canteenHandler.SoftDeleteMenuCategory)
+	routerGroup.Delete("/menu/order/:id", middleware.Authentication, canteenHandler.CancelOrder)
 	routerGroup.Delete("/menu/order/feedback/:id", middleware.Authentication, middleware.Canteen, canteenHandler.SoftDeleteFeedback)
+	routerGroup.Delete("/voucher/:id", middleware.Authentication, middleware.AdminOrCanteen, canteenHandler.SoftDeleteVoucher)
 }
 
 func (c *CanteenHandler) CreateCanteen(ctx *fiber.Ctx) error {
@@ -96,6 +144,7 @@ func (c *CanteenHandler) CreateCanteen(ctx *fiber.Ctx) error {
 
 func (c *CanteenHandler) CreateMenu(ctx *fiber.Ctx) error {
 	var createMenu dto.CreateMenu
+	var fiberError *fiber.Error
 
 	userID, err := uuid.Parse(ctx.Locals("userID").(string))
 	if err != nil {
@@ -121,8 +170,20 @@ func (c *CanteenHandler) CreateMenu(ctx *fiber.Ctx) error {
 		)
 	}
 
-	res, err := c.CanteenUseCase.CreateMenu(createMenu, userID)
+	createMenu.Image, err = c.menuImage(ctx)
 	if err != nil {
+		return err
+	}
+
+	res, err := c.CanteenUseCase.CreateMenu(createMenu, userID)
+	if err == repository.ErrInvalidCategory || err == repository.ErrInvalidComponents {
+		return fiber.NewError(
+			http.StatusUnprocessableEntity,
+			err.Error(),
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
 		return fiber.NewError(
 			http.StatusInternalServerError,
 			"failed to create menu",
@@ -135,6 +196,98 @@ func (c *CanteenHandler) CreateMenu(ctx *fiber.Ctx) error {
 	})
 }
 
+func (c *CanteenHandler) menuImage(ctx *fiber.Ctx) ([]byte, error) {
+	if !strings.HasPrefix(ctx.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		return nil, nil
+	}
+
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		return nil, fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	files := form.File["image"]
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	if files[0].Size > int64(c.Config.BodyLimit)*1024*1024 {
+		return nil, fiber.NewError(
+			http.StatusRequestEntityTooLarge,
+			"image too large",
+		)
+	}
+
+	file, err := files[0].Open()
+	if err != nil {
+		return nil, fiber.NewError(
+			http.StatusBadRequest,
+			"failed to read image",
+		)
+	}
+
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fiber.NewError(
+			http.StatusBadRequest,
+			"failed to read image",
+		)
+	}
+
+	return data, nil
+}
+
+func (c *CanteenHandler) CreateMenuCategory(ctx *fiber.Ctx) error {
+	var createMenuCategory dto.CreateMenuCategory
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	err = ctx.BodyParser(&createMenuCategory)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	err = c.Validator.Struct(createMenuCategory)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.CreateMenuCategory(createMenuCategory, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"canteen not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to create menu category",
+		)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"message": "menu category created",
+		"payload": res,
+	})
+}
+
 func (c *CanteenHandler) CreateOrder(ctx *fiber.Ctx) error {
 	var createOrder dto.CreateOrder
 
@@ -165,15 +318,30 @@ func (c *CanteenHandler) CreateOrder(ctx *fiber.Ctx) error {
 	}
 
 	res, err := c.CanteenUseCase.CreateOrder(createOrder)
-	if err == gorm.ErrInvalidValue {
+	if err == repository.ErrInsufficientStock {
 		return fiber.NewError(
-			http.StatusBadRequest,
-			"invalid quantity",
+			http.StatusConflict,
+			"insufficient stock",
+		)
+	} else if err == repository.ErrInvalidVoucher || err == repository.ErrInvalidOptions || err == repository.ErrMenuUnavailable {
+		return fiber.NewError(
+			http.StatusUnprocessableEntity,
+			err.Error(),
+		)
+	} else if err == repository.ErrVoucherLimitReached {
+		return fiber.NewError(
+			http.StatusConflict,
+			err.Error(),
+		)
+	} else if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu not found",
 		)
 	} else if err != nil {
 		return fiber.NewError(
-			http.StatusBadRequest,
-			"invalid request body",
+			http.StatusInternalServerError,
+			"failed to create order",
 		)
 	}
 
@@ -185,6 +353,8 @@ func (c *CanteenHandler) CreateOrder(ctx *fiber.Ctx) error {
 
 func (c *CanteenHandler) CreatePayment(ctx *fiber.Ctx) error {
 	var createPayment dto.CreatePayment
+	var transitionError *orderstatus.TransitionError
+	var fiberError *fiber.Error
 
 	userID, err := uuid.Parse(ctx.Locals("userID").(string))
 	if err != nil {
@@ -203,6 +373,7 @@ func (c *CanteenHandler) CreatePayment(ctx *fiber.Ctx) error {
 	}
 
 	createPayment.UserID = userID
+	createPayment.Purpose = paymentmethod.Order
 
 	err = c.Validator.Struct(createPayment)
 	if err != nil {
@@ -213,7 +384,44 @@ func (c *CanteenHandler) CreatePayment(ctx *fiber.Ctx) error {
 	}
 
 	res, err := c.CanteenUseCase.CreatePayment(createPayment)
-	if err != nil {
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"order not found",
+		)
+	} else if err == repository.ErrInsufficientBalance {
+		return fiber.NewError(
+			http.StatusPaymentRequired,
+			"insufficient wallet balance",
+		)
+	} else if err == repository.ErrInvalidVoucher {
+		return fiber.NewError(
+			http.StatusUnprocessableEntity,
+			err.Error(),
+		)
+	} else if err == repository.ErrVoucherLimitReached || err == repository.ErrVoucherAlreadyApplied {
+		return fiber.NewError(
+			http.StatusConflict,
+			err.Error(),
+		)
+	} else if err == repository.ErrInsufficientPoints {
+		return fiber.NewError(
+			http.StatusUnprocessableEntity,
+			err.Error(),
+		)
+	} else if err == repository.ErrPointsAlreadyUsed {
+		return fiber.NewError(
+			http.StatusConflict,
+			err.Error(),
+		)
+	} else if errors.As(err, &transitionError) {
+		return fiber.NewError(
+			http.StatusConflict,
+			transitionError.Error(),
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
 		return fiber.NewError(
 			http.StatusInternalServerError,
 			"failed to create payment",
@@ -226,32 +434,49 @@ func (c *CanteenHandler) CreatePayment(ctx *fiber.Ctx) error {
 	})
 }
 
-func (c *CanteenHandler) VerifyPayment(ctx *fiber.Ctx) error {
-	var verifyPayment dto.VerifyPayment
+func (c *CanteenHandler) ConfirmCashPayment(ctx *fiber.Ctx) error {
+	var transitionError *orderstatus.TransitionError
 
-	err := ctx.BodyParser(&verifyPayment)
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
 	if err != nil {
 		return fiber.NewError(
-			http.StatusBadRequest,
-			"failed to parse request body",
+			http.StatusUnauthorized,
+			"user unauthorized",
 		)
 	}
 
-	err = c.CanteenUseCase.VerifyPayment(verifyPayment)
+	orderID, err := uuid.Parse(ctx.Params("id"))
 	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid order id",
+		)
+	}
+
+	err = c.CanteenUseCase.ConfirmCashPayment(orderID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"pending cash payment not found",
+		)
+	} else if errors.As(err, &transitionError) {
+		return fiber.NewError(
+			http.StatusConflict,
+			transitionError.Error(),
+		)
+	} else if err != nil {
 		return fiber.NewError(
 			http.StatusInternalServerError,
-			"failed to verify payment",
+			"failed to confirm cash payment",
 		)
 	}
 
-	return ctx.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "payment verified",
-	})
+	return ctx.Status(http.StatusNoContent).Context().Err()
 }
 
-func (c *CanteenHandler) CreateFeedback(ctx *fiber.Ctx) error {
-	var createFeedback dto.CreateFeedback
+func (c *CanteenHandler) TopUpWallet(ctx *fiber.Ctx) error {
+	var topUpWallet dto.TopUpWallet
+	var fiberError *fiber.Error
 
 	userID, err := uuid.Parse(ctx.Locals("userID").(string))
 	if err != nil {
@@ -261,7 +486,7 @@ func (c *CanteenHandler) CreateFeedback(ctx *fiber.Ctx) error {
 		)
 	}
 
-	err = ctx.BodyParser(&createFeedback)
+	err = ctx.BodyParser(&topUpWallet)
 	if err != nil {
 		return fiber.NewError(
 			http.StatusBadRequest,
@@ -269,9 +494,9 @@ func (c *CanteenHandler) CreateFeedback(ctx *fiber.Ctx) error {
 		)
 	}
 
-	createFeedback.UserID = userID
+	topUpWallet.UserID = userID
 
-	err = c.Validator.Struct(createFeedback)
+	err = c.Validator.Struct(topUpWallet)
 	if err != nil {
 		return fiber.NewError(
 			http.StatusBadRequest,
@@ -279,102 +504,114 @@ func (c *CanteenHandler) CreateFeedback(ctx *fiber.Ctx) error {
 		)
 	}
 
-	res, err := c.CanteenUseCase.CreateFeedback(createFeedback)
-	if err == gorm.ErrRecordNotFound {
-		return fiber.NewError(
-			http.StatusNotFound,
-			"order not found",
-		)
+	res, err := c.CanteenUseCase.CreatePayment(dto.CreatePayment{
+		UserID:  topUpWallet.UserID,
+		Price:   topUpWallet.Amount,
+		Purpose: paymentmethod.TopUp,
+	})
+	if errors.As(err, &fiberError) {
+		return fiberError
 	} else if err != nil {
 		return fiber.NewError(
 			http.StatusInternalServerError,
-			"failed to create feedback",
+			"failed to create top-up payment",
 		)
 	}
 
 	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
-		"message": "feedback created",
+		"message": "top-up payment created",
 		"payload": res,
 	})
 }
 
-func (c *CanteenHandler) UpdateMenu(ctx *fiber.Ctx) error {
-	var updateMenu dto.UpdateMenu
+func (c *CanteenHandler) VerifyPayment(ctx *fiber.Ctx) error {
+	var verifyPayment dto.VerifyPayment
+	var transitionError *orderstatus.TransitionError
+	var fiberError *fiber.Error
 
-	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	err := ctx.BodyParser(&verifyPayment)
 	if err != nil {
 		return fiber.NewError(
-			http.StatusUnauthorized,
-			"user unauthorized",
+			http.StatusBadRequest,
+			"failed to parse request body",
 		)
 	}
 
-	menuID, err := uuid.Parse(ctx.Params("id"))
-	if err != nil {
+	err = c.CanteenUseCase.VerifyPayment(verifyPayment)
+	if err == gorm.ErrRecordNotFound {
 		return fiber.NewError(
-			http.StatusBadRequest,
-			"invalid menu id",
+			http.StatusNotFound,
+			"payment not found",
 		)
-	}
-
-	err = ctx.BodyParser(&updateMenu)
-	if err != nil {
+	} else if errors.As(err, &transitionError) {
 		return fiber.NewError(
-			http.StatusBadRequest,
-			"failed to parse request body",
+			http.StatusConflict,
+			transitionError.Error(),
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to verify payment",
 		)
 	}
 
-	updateMenu.ID = menuID
-	updateMenu.UserID = userID
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "payment verified",
+	})
+}
 
-	err = c.Validator.Struct(updateMenu)
+func (c *CanteenHandler) ReplayPaymentNotification(ctx *fiber.Ctx) error {
+	var transitionError *orderstatus.TransitionError
+	var fiberError *fiber.Error
+
+	notificationID, err := uuid.Parse(ctx.Params("id"))
 	if err != nil {
 		return fiber.NewError(
 			http.StatusBadRequest,
-			"invalid request body",
+			"invalid notification id",
 		)
 	}
 
-	res, err := c.CanteenUseCase.UpdateMenu(updateMenu)
+	err = c.CanteenUseCase.ReplayPaymentNotification(notificationID)
 	if err == gorm.ErrRecordNotFound {
 		return fiber.NewError(
 			http.StatusNotFound,
-			"menu not found",
+			"notification not found",
+		)
+	} else if errors.As(err, &transitionError) {
+		return fiber.NewError(
+			http.StatusConflict,
+			transitionError.Error(),
 		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
 	} else if err != nil {
 		return fiber.NewError(
 			http.StatusInternalServerError,
-			"failed to delete menu",
+			"failed to replay notification",
 		)
 	}
 
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "menu updated",
-		"payload": res,
+		"message": "notification replayed",
 	})
 }
 
-func (c *CanteenHandler) UpdateOrder(ctx *fiber.Ctx) error {
-	var updateOrder dto.UpdateOrder
-
-	orderID, err := uuid.Parse(ctx.Params("id"))
-	if err != nil {
-		return fiber.NewError(
-			http.StatusBadRequest,
-			"invalid order id",
-		)
-	}
+func (c *CanteenHandler) RefundOrder(ctx *fiber.Ctx) error {
+	var createRefund dto.CreateRefund
+	var transitionError *orderstatus.TransitionError
 
 	userID, err := uuid.Parse(ctx.Locals("userID").(string))
 	if err != nil {
 		return fiber.NewError(
-			http.StatusBadRequest,
-			"invalid user id",
+			http.StatusUnauthorized,
+			"user unauthorized",
 		)
 	}
 
-	err = ctx.BodyParser(&updateOrder)
+	err = ctx.BodyParser(&createRefund)
 	if err != nil {
 		return fiber.NewError(
 			http.StatusBadRequest,
@@ -382,9 +619,7 @@ func (c *CanteenHandler) UpdateOrder(ctx *fiber.Ctx) error {
 		)
 	}
 
-	updateOrder.ID = orderID
-
-	err = c.Validator.Struct(updateOrder)
+	err = c.Validator.Struct(createRefund)
 	if err != nil {
 		return fiber.NewError(
 			http.StatusBadRequest,
@@ -392,141 +627,1428 @@ func (c *CanteenHandler) UpdateOrder(ctx *fiber.Ctx) error {
 		)
 	}
 
-	res, err := c.CanteenUseCase.UpdateOrder(updateOrder, userID)
+	res, err := c.CanteenUseCase.RefundOrder(createRefund, userID, ctx.Locals("role").(string))
 	if err == gorm.ErrRecordNotFound {
 		return fiber.NewError(
 			http.StatusNotFound,
-			"order not found",
+			"settled payment not found",
+		)
+	} else if err == repository.ErrInvalidRefundAmount {
+		return fiber.NewError(
+			http.StatusUnprocessableEntity,
+			"invalid refund amount",
+		)
+	} else if errors.As(err, &transitionError) {
+		return fiber.NewError(
+			http.StatusConflict,
+			transitionError.Error(),
 		)
 	} else if err != nil {
 		return fiber.NewError(
-			http.StatusInternalServerError,
-			"failed to delete order",
+			http.StatusBadGateway,
+			"failed to refund payment, refund will be retried",
 		)
 	}
 
-	return ctx.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "order updated",
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"message": "payment refunded",
 		"payload": res,
 	})
 }
 
-func (c *CanteenHandler) GetCanteenList(ctx *fiber.Ctx) error {
-	res, err := c.CanteenUseCase.GetCanteenList()
+func (c *CanteenHandler) GeneratePayouts(ctx *fiber.Ctx) error {
+	var createPayout dto.CreatePayout
+
+	err := ctx.BodyParser(&createPayout)
 	if err != nil {
 		return fiber.NewError(
-			http.StatusInternalServerError,
-			"failed to get canteen list",
+			http.StatusBadRequest,
+			"failed to parse request body",
 		)
 	}
 
-	return ctx.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "successfully retrieved canteen list",
-		"payload": res,
-	})
-}
-
-func (c *CanteenHandler) GetCanteenInfo(ctx *fiber.Ctx) error {
-	canteenID, err := uuid.Parse(ctx.Params("id"))
+	err = c.Validator.Struct(createPayout)
 	if err != nil {
 		return fiber.NewError(
 			http.StatusBadRequest,
-			"invalid canteen id",
+			"invalid request body",
 		)
 	}
 
-	res, err := c.CanteenUseCase.GetCanteenInfo(canteenID)
+	res, err := c.CanteenUseCase.GeneratePayouts(settlement.Period(createPayout.Period))
 	if err != nil {
 		return fiber.NewError(
 			http.StatusInternalServerError,
-			"failed to get canteen info",
+			"failed to generate payouts",
 		)
 	}
 
-	return ctx.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "successfully retrieved canteen info",
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"message": "payouts generated",
 		"payload": res,
 	})
 }
 
-func (c *CanteenHandler) GetMenuInfo(ctx *fiber.Ctx) error {
-	menuID, err := uuid.Parse(ctx.Params("id"))
+func (c *CanteenHandler) MarkPayoutPaid(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
 	if err != nil {
 		return fiber.NewError(
-			http.StatusBadRequest,
-			"invalid menu id",
+			http.StatusUnauthorized,
+			"user unauthorized",
 		)
 	}
 
-	res, err := c.CanteenUseCase.GetMenuInfo(menuID)
+	payoutID, err := uuid.Parse(ctx.Params("id"))
 	if err != nil {
 		return fiber.NewError(
-			http.StatusInternalServerError,
-			"failed to get menu info",
+			http.StatusBadRequest,
+			"invalid payout id",
 		)
 	}
 
-	return ctx.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "successfully get menu info",
-		"payload": res,
-	})
-}
-
+	res, err := c.CanteenUseCase.MarkPayoutPaid(payoutID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"payout not found",
+		)
+	} else if err == repository.ErrPayoutAlreadyPaid {
+		return fiber.NewError(
+			http.StatusConflict,
+			"payout already paid",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to mark payout as paid",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "payout marked as paid",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) CreatePromotion(ctx *fiber.Ctx) error {
+	var createPromotion dto.CreatePromotion
+	var fiberError *fiber.Error
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	menuID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu id",
+		)
+	}
+
+	err = ctx.BodyParser(&createPromotion)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	createPromotion.MenuID = menuID
+
+	err = c.Validator.Struct(createPromotion)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.CreatePromotion(createPromotion, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu not found",
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to create promotion",
+		)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"message": "promotion created",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) CreateMenuAvailability(ctx *fiber.Ctx) error {
+	var createMenuAvailability dto.CreateMenuAvailability
+	var fiberError *fiber.Error
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	menuID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu id",
+		)
+	}
+
+	err = ctx.BodyParser(&createMenuAvailability)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	createMenuAvailability.MenuID = menuID
+
+	err = c.Validator.Struct(createMenuAvailability)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.CreateMenuAvailability(createMenuAvailability, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu not found",
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to create menu availability",
+		)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"message": "menu availability created",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) CreateModifierGroup(ctx *fiber.Ctx) error {
+	var createModifierGroup dto.CreateModifierGroup
+	var fiberError *fiber.Error
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	menuID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu id",
+		)
+	}
+
+	err = ctx.BodyParser(&createModifierGroup)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	createModifierGroup.MenuID = menuID
+
+	err = c.Validator.Struct(createModifierGroup)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.CreateModifierGroup(createModifierGroup, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu not found",
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to create modifier group",
+		)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"message": "modifier group created",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) CreateFeedback(ctx *fiber.Ctx) error {
+	var createFeedback dto.CreateFeedback
+	var transitionError *orderstatus.TransitionError
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	err = ctx.BodyParser(&createFeedback)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	createFeedback.UserID = userID
+
+	err = c.Validator.Struct(createFeedback)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.CreateFeedback(createFeedback)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"order not found",
+		)
+	} else if errors.As(err, &transitionError) {
+		return fiber.NewError(
+			http.StatusConflict,
+			transitionError.Error(),
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to create feedback",
+		)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"message": "feedback created",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) CreateVoucher(ctx *fiber.Ctx) error {
+	var createVoucher dto.CreateVoucher
+	var fiberError *fiber.Error
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	err = ctx.BodyParser(&createVoucher)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	err = c.Validator.Struct(createVoucher)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.CreateVoucher(createVoucher, userID, ctx.Locals("role").(string))
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"canteen or menu not found",
+		)
+	} else if err == repository.ErrDuplicateVoucherCode {
+		return fiber.NewError(
+			http.StatusConflict,
+			"please use another voucher code",
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to create voucher",
+		)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"message": "voucher created",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) UpdateCanteen(ctx *fiber.Ctx) error {
+	var updateCanteen dto.UpdateCanteen
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	canteenID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid canteen id",
+		)
+	}
+
+	err = ctx.BodyParser(&updateCanteen)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	updateCanteen.ID = canteenID
+	updateCanteen.UserID = userID
+
+	err = c.Validator.Struct(updateCanteen)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.UpdateCanteen(updateCanteen)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"canteen not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to update canteen",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "canteen updated",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) UpdateMenu(ctx *fiber.Ctx) error {
+	var updateMenu dto.UpdateMenu
+	var fiberError *fiber.Error
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	menuID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu id",
+		)
+	}
+
+	err = ctx.BodyParser(&updateMenu)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	updateMenu.ID = menuID
+	updateMenu.UserID = userID
+
+	err = c.Validator.Struct(updateMenu)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	updateMenu.Image, err = c.menuImage(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.CanteenUseCase.UpdateMenu(updateMenu)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu not found",
+		)
+	} else if err == repository.ErrInvalidCategory {
+		return fiber.NewError(
+			http.StatusUnprocessableEntity,
+			err.Error(),
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to delete menu",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "menu updated",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) UpdateModifierOption(ctx *fiber.Ctx) error {
+	var updateModifierOption dto.UpdateModifierOption
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	optionID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid option id",
+		)
+	}
+
+	err = ctx.BodyParser(&updateModifierOption)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	updateModifierOption.ID = optionID
+	updateModifierOption.UserID = userID
+
+	err = c.Validator.Struct(updateModifierOption)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.UpdateModifierOption(updateModifierOption)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"modifier option not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to update modifier option",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "modifier option updated",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) RestockMenu(ctx *fiber.Ctx) error {
+	var restockMenu dto.RestockMenu
+	var fiberError *fiber.Error
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	err = ctx.BodyParser(&restockMenu)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	restockMenu.UserID = userID
+
+	err = c.Validator.Struct(restockMenu)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.RestockMenu(restockMenu)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu not found",
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to restock menu",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "menu restocked",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) UpdateMenuCategory(ctx *fiber.Ctx) error {
+	var updateMenuCategory dto.UpdateMenuCategory
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	categoryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid category id",
+		)
+	}
+
+	err = ctx.BodyParser(&updateMenuCategory)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	updateMenuCategory.ID = categoryID
+	updateMenuCategory.UserID = userID
+
+	err = c.Validator.Struct(updateMenuCategory)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.UpdateMenuCategory(updateMenuCategory)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu category not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to update menu category",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "menu category updated",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) UpdateOrder(ctx *fiber.Ctx) error {
+	var updateOrder dto.UpdateOrder
+	var transitionError *orderstatus.TransitionError
+
+	orderID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid order id",
+		)
+	}
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid user id",
+		)
+	}
+
+	err = ctx.BodyParser(&updateOrder)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	updateOrder.ID = orderID
+
+	err = c.Validator.Struct(updateOrder)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.UpdateOrder(updateOrder, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"order not found",
+		)
+	} else if errors.As(err, &transitionError) {
+		return fiber.NewError(
+			http.StatusConflict,
+			transitionError.Error(),
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to delete order",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "order updated",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) CancelOrder(ctx *fiber.Ctx) error {
+	var cancelOrder dto.CancelOrder
+	var transitionError *orderstatus.TransitionError
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	orderID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid order id",
+		)
+	}
+
+	cancelOrder.ID = orderID
+	cancelOrder.UserID = userID
+
+	err = c.CanteenUseCase.CancelOrder(cancelOrder)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"order not found",
+		)
+	} else if errors.As(err, &transitionError) {
+		return fiber.NewError(
+			http.StatusConflict,
+			transitionError.Error(),
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to cancel order",
+		)
+	}
+
+	return ctx.Status(http.StatusNoContent).Context().Err()
+}
+
+func (c *CanteenHandler) RejectOrder(ctx *fiber.Ctx) error {
+	var rejectOrder dto.RejectOrder
+	var transitionError *orderstatus.TransitionError
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	orderID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid order id",
+		)
+	}
+
+	err = ctx.BodyParser(&rejectOrder)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse request body",
+		)
+	}
+
+	rejectOrder.ID = orderID
+
+	err = c.Validator.Struct(rejectOrder)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid request body",
+		)
+	}
+
+	res, err := c.CanteenUseCase.RejectOrder(rejectOrder, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"order not found",
+		)
+	} else if errors.As(err, &transitionError) {
+		return fiber.NewError(
+			http.StatusConflict,
+			transitionError.Error(),
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to reject order",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "order rejected",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetCanteenList(ctx *fiber.Ctx) error {
+	res, err := c.CanteenUseCase.GetCanteenList()
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get canteen list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved canteen list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetCanteenInfo(ctx *fiber.Ctx) error {
+	canteenID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid canteen id",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetCanteenInfo(canteenID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get canteen info",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved canteen info",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetCanteenMenu(ctx *fiber.Ctx) error {
+	canteenID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid canteen id",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetCanteenMenu(canteenID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"canteen not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get canteen menu",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved canteen menu",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetMenuList(ctx *fiber.Ctx) error {
+	var getMenuList dto.GetMenuList
+
+	canteenID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid canteen id",
+		)
+	}
+
+	err = ctx.QueryParser(&getMenuList)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"failed to parse query",
+		)
+	}
+
+	getMenuList.CanteenID = canteenID
+
+	err = c.Validator.Struct(getMenuList)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid query",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetMenuList(getMenuList)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"canteen not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get menu list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved menu list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetMenuCategoryList(ctx *fiber.Ctx) error {
+	canteenID, err := uuid.Parse(ctx.Query("canteen_id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid canteen id",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetMenuCategoryList(canteenID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get menu category list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved menu category list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetMenuInfo(ctx *fiber.Ctx) error {
+	menuID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu id",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetMenuInfo(menuID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get menu info",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully get menu info",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetPromotionList(ctx *fiber.Ctx) error {
+	menuID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu id",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetPromotionList(menuID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get promotion list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved promotion list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetMenuAvailabilityList(ctx *fiber.Ctx) error {
+	menuID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu id",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetMenuAvailabilityList(menuID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get menu availability list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved menu availability list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetModifierGroupList(ctx *fiber.Ctx) error {
+	menuID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu id",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetModifierGroupList(menuID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get modifier group list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved modifier group list",
+		"payload": res,
+	})
+}
+
 func (c *CanteenHandler) GetOrderInfo(ctx *fiber.Ctx) error {
 	var getOrderInfo dto.GetOrderInfo
 
-	orderID, err := uuid.Parse(ctx.Params("id"))
+	orderID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid order id",
+		)
+	}
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid user id",
+		)
+	}
+
+	getOrderInfo.UserID = userID
+	getOrderInfo.ID = orderID
+
+	res, err := c.CanteenUseCase.GetOrderInfo(getOrderInfo)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get order info",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved order info",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetOrderList(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid user id",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetOrderList(userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"order list empty",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved order list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetReceipt(ctx *fiber.Ctx) error {
+	var fiberError *fiber.Error
+
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	orderID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid order id",
+		)
+	}
+
+	getOrderInfo := dto.GetOrderInfo{
+		ID:     orderID,
+		UserID: userID,
+	}
+
+	if ctx.Query("format") == "pdf" {
+		res, err := c.CanteenUseCase.GetReceiptPDF(getOrderInfo)
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(
+				http.StatusNotFound,
+				"order not found",
+			)
+		} else if errors.As(err, &fiberError) {
+			return fiberError
+		} else if err != nil {
+			return fiber.NewError(
+				http.StatusInternalServerError,
+				"failed to render receipt",
+			)
+		}
+
+		ctx.Attachment("receipt-" + orderID.String() + ".pdf")
+
+		return ctx.Status(http.StatusOK).Send(res)
+	}
+
+	res, err := c.CanteenUseCase.GetReceipt(getOrderInfo)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"order not found",
+		)
+	} else if errors.As(err, &fiberError) {
+		return fiberError
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get receipt",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved receipt",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetPaymentNotificationList(ctx *fiber.Ctx) error {
+	res, err := c.CanteenUseCase.GetPaymentNotificationList()
 	if err != nil {
 		return fiber.NewError(
-			http.StatusBadRequest,
-			"invalid order id",
+			http.StatusInternalServerError,
+			"failed to get payment notification list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved payment notification list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetRefundList(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetRefundList(userID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get refund list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved refund list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetReconciliationReportList(ctx *fiber.Ctx) error {
+	res, err := c.CanteenUseCase.GetReconciliationReportList()
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get reconciliation report list",
 		)
 	}
 
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved reconciliation report list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetPayout(ctx *fiber.Ctx) error {
 	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	payoutID, err := uuid.Parse(ctx.Params("id"))
 	if err != nil {
 		return fiber.NewError(
 			http.StatusBadRequest,
-			"invalid user id",
+			"invalid payout id",
 		)
 	}
 
-	getOrderInfo.UserID = userID
-	getOrderInfo.ID = orderID
+	res, err := c.CanteenUseCase.GetPayout(payoutID, userID, ctx.Locals("role").(string))
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"payout not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get payout",
+		)
+	}
 
-	res, err := c.CanteenUseCase.GetOrderInfo(getOrderInfo)
+	if ctx.Query("format") == "csv" {
+		records := [][]string{
+			{"payment_id", "order_id", "method", "amount", "refunded_amount", "payment_date"},
+		}
+
+		for _, item := range res.Items {
+			records = append(records, []string{
+				item.PaymentID.String(),
+				item.OrderID.String(),
+				item.Method,
+				strconv.FormatUint(uint64(item.Amount), 10),
+				strconv.FormatUint(uint64(item.RefundedAmount), 10),
+				item.PaymentDate.Format(time.RFC3339),
+			})
+		}
+
+		for _, adjustment := range res.Adjustments {
+			records = append(records, []string{
+				adjustment.PaymentID.String(),
+				adjustment.OrderID.String(),
+				adjustment.Method,
+				strconv.FormatInt(adjustment.Amount, 10),
+				"0",
+				adjustment.RefundDate.Format(time.RFC3339),
+			})
+		}
+
+		return sendCSV(ctx, "payout-"+res.ID.String()+".csv", records)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved payout",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetPayoutList(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetPayoutList(userID, ctx.Locals("role").(string))
 	if err != nil {
 		return fiber.NewError(
 			http.StatusInternalServerError,
-			"failed to get order info",
+			"failed to get payout list",
 		)
 	}
 
+	if ctx.Query("format") == "csv" {
+		records := [][]string{
+			{
+				"id", "canteen_id", "period", "period_start", "period_end", "payment_count", "gross_amount",
+				"refunded_amount", "cash_amount", "commission_rate", "commission", "adjustment_amount", "net_amount",
+				"status",
+			},
+		}
+
+		for _, payout := range res {
+			records = append(records, []string{
+				payout.ID.String(),
+				payout.CanteenID.String(),
+				payout.Period,
+				payout.PeriodStart.Format(time.RFC3339),
+				payout.PeriodEnd.Format(time.RFC3339),
+				strconv.FormatUint(uint64(payout.PaymentCount), 10),
+				strconv.FormatUint(uint64(payout.GrossAmount), 10),
+				strconv.FormatUint(uint64(payout.RefundedAmount), 10),
+				strconv.FormatUint(uint64(payout.CashAmount), 10),
+				strconv.FormatUint(uint64(payout.CommissionRate), 10),
+				strconv.FormatInt(payout.Commission, 10),
+				strconv.FormatInt(payout.AdjustmentAmount, 10),
+				strconv.FormatInt(payout.NetAmount, 10),
+				payout.Status,
+			})
+		}
+
+		return sendCSV(ctx, "payouts.csv", records)
+	}
+
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "successfully retrieved order info",
+		"message": "successfully retrieved payout list",
 		"payload": res,
 	})
 }
 
-func (c *CanteenHandler) GetOrderList(ctx *fiber.Ctx) error {
+func (c *CanteenHandler) GetVoucherList(ctx *fiber.Ctx) error {
 	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	res, err := c.CanteenUseCase.GetVoucherList(userID, ctx.Locals("role").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get voucher list",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "successfully retrieved voucher list",
+		"payload": res,
+	})
+}
+
+func (c *CanteenHandler) GetReconciliationReport(ctx *fiber.Ctx) error {
+	reportID, err := uuid.Parse(ctx.Params("id"))
 	if err != nil {
 		return fiber.NewError(
 			http.StatusBadRequest,
-			"invalid user id",
+			"invalid report id",
 		)
 	}
 
-	res, err := c.CanteenUseCase.GetOrderList(userID)
+	res, err := c.CanteenUseCase.GetReconciliationReport(reportID)
 	if err == gorm.ErrRecordNotFound {
 		return fiber.NewError(
 			http.StatusNotFound,
-			"order list empty",
+			"reconciliation report not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get reconciliation report",
 		)
 	}
 
 	return ctx.Status(http.StatusOK).JSON(fiber.Map{
-		"message": "successfully retrieved order list",
+		"message": "successfully retrieved reconciliation report",
 		"payload": res,
 	})
 }
@@ -587,6 +2109,138 @@ func (c *CanteenHandler) SoftDeleteMenu(ctx *fiber.Ctx) error {
 	return ctx.Status(http.StatusNoContent).Context().Err()
 }
 
+func (c *CanteenHandler) SoftDeletePromotion(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	promotionID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid promotion id",
+		)
+	}
+
+	err = c.CanteenUseCase.SoftDeletePromotion(promotionID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"promotion not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to delete promotion",
+		)
+	}
+
+	return ctx.Status(http.StatusNoContent).Context().Err()
+}
+
+func (c *CanteenHandler) SoftDeleteMenuAvailability(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	availabilityID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid menu availability id",
+		)
+	}
+
+	err = c.CanteenUseCase.SoftDeleteMenuAvailability(availabilityID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu availability not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to delete menu availability",
+		)
+	}
+
+	return ctx.Status(http.StatusNoContent).Context().Err()
+}
+
+func (c *CanteenHandler) SoftDeleteModifierGroup(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	groupID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid modifier group id",
+		)
+	}
+
+	err = c.CanteenUseCase.SoftDeleteModifierGroup(groupID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"modifier group not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to delete modifier group",
+		)
+	}
+
+	return ctx.Status(http.StatusNoContent).Context().Err()
+}
+
+func (c *CanteenHandler) SoftDeleteMenuCategory(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	categoryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid category id",
+		)
+	}
+
+	err = c.CanteenUseCase.SoftDeleteMenuCategory(categoryID, userID)
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"menu category not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to delete menu category",
+		)
+	}
+
+	return ctx.Status(http.StatusNoContent).Context().Err()
+}
+
 func (c *CanteenHandler) SoftDeleteFeedback(ctx *fiber.Ctx) error {
 	userID, err := uuid.Parse(ctx.Locals("userID").(string))
 	if err != nil {
@@ -619,3 +2273,52 @@ func (c *CanteenHandler) SoftDeleteFeedback(ctx *fiber.Ctx) error {
 
 	return ctx.Status(http.StatusNoContent).Context().Err()
 }
+
+func (c *CanteenHandler) SoftDeleteVoucher(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	voucherID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid voucher id",
+		)
+	}
+
+	err = c.CanteenUseCase.SoftDeleteVoucher(voucherID, userID, ctx.Locals("role").(string))
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(
+			http.StatusNotFound,
+			"voucher not found",
+		)
+	} else if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to delete voucher",
+		)
+	}
+
+	return ctx.Status(http.StatusNoContent).Context().Err()
+}
+
+func sendCSV(ctx *fiber.Ctx, filename string, records [][]string) error {
+	var buf bytes.Buffer
+
+	err := csv.NewWriter(&buf).WriteAll(records)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to write csv",
+		)
+	}
+
+	ctx.Attachment(filename)
+
+	return ctx.Status(http.StatusOK).Send(buf.Bytes())
+}
@@ -2,28 +2,135 @@
 package repository
 
 import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/entity"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/ledger"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/loyalty"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/menutype"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/orderstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentmethod"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/refundstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/settlement"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrInsufficientStock     = errors.New("insufficient stock")
+	ErrDuplicateNotification = errors.New("duplicate payment notification")
+	ErrInvalidRefundAmount   = errors.New("invalid refund amount")
+	ErrInsufficientBalance   = errors.New("insufficient wallet balance")
+	ErrPayoutAlreadyPaid     = errors.New("payout already paid")
+	ErrDuplicateVoucherCode  = errors.New("voucher code already exists")
+	ErrInvalidVoucher        = errors.New("voucher is not applicable to this order")
+	ErrVoucherLimitReached   = errors.New("voucher usage limit reached")
+	ErrVoucherAlreadyApplied = errors.New("order already has a voucher")
+	ErrInsufficientPoints    = errors.New("insufficient loyalty points")
+	ErrPointsAlreadyUsed     = errors.New("order already uses loyalty points")
+	ErrInvalidCategory       = errors.New("category does not belong to the canteen")
+	ErrInvalidOptions        = errors.New("invalid menu options")
+	ErrInvalidComponents     = errors.New("bundle components must be single menus of the same canteen")
+	ErrMenuUnavailable       = errors.New("menu is not available at this time")
 )
 
+type MenuFilter struct {
+	CanteenID  uuid.UUID
+	Sort       string
+	Descending bool
+	InStock    bool
+	Available  bool
+	Now        time.Time
+	MinPrice   uint32
+	MaxPrice   uint32
+	Offset     int
+	Limit      int
+}
+
+type Restock struct {
+	MenuID   uuid.UUID
+	Stock    uint32
+	ParLevel *uint32
+}
+
 type CanteenDBItf interface {
 	CreateCanteen(canteen *entity.Canteen) error
 	CreateMenu(menu *entity.Menu, userID uuid.UUID) error
-	CreateOrder(menu *entity.Menu, order *entity.Order) error
+	CreateMenuCategory(category *entity.MenuCategory, userID uuid.UUID) error
+	CreateOrder(order *entity.Order, now time.Time, price func(order *entity.Order)) error
 	CreatePayment(payment *entity.Payment) error
-	VerifyPayment(order *entity.Order) error
+	ApplyVoucher(order *entity.Order, price func(order *entity.Order)) error
+	UsePoints(order *entity.Order, price func(order *entity.Order)) error
+	PayWithWallet(payment *entity.Payment) error
+	PayWithCash(payment *entity.Payment) error
+	ConfirmCashPayment(payment *entity.Payment, userID uuid.UUID) error
+	VerifyPayment(payment *entity.Payment, refund *entity.Refund) error
+	CreatePaymentNotification(notification *entity.PaymentNotification) error
+	UpdatePaymentNotification(notification *entity.PaymentNotification) error
+	CreateReconciliationReport(report *entity.ReconciliationReport) error
+	CreatePayout(payout *entity.Payout) error
+	UpdatePayoutPaid(payout *entity.Payout) error
+	ReserveRefund(refund *entity.Refund, ownerID uuid.UUID) error
+	CompleteRefund(refund *entity.Refund) error
+	UpdateRefundAttempt(refund *entity.Refund) error
 	CreateFeedback(feedback *entity.Feedback) error
+	CreateVoucher(voucher *entity.Voucher, userID uuid.UUID) error
+	CreatePromotion(promotion *entity.Promotion, menu *entity.Menu, userID uuid.UUID) error
+	CreateModifierGroup(group *entity.ModifierGroup, menu *entity.Menu, userID uuid.UUID) error
+	CreateMenuAvailability(availability *entity.MenuAvailability, menu *entity.Menu, userID uuid.UUID) error
+	UpdateCanteen(canteen *entity.Canteen, userID uuid.UUID) error
 	UpdateMenu(menu *entity.Menu, userID uuid.UUID) error
-	UpdateOrder(order *entity.Order, userID uuid.UUID) error
+	UpdateMenuCategory(category *entity.MenuCategory, userID uuid.UUID) error
+	UpdateModifierOption(option *entity.ModifierOption, menu *entity.Menu, userID uuid.UUID) error
+	RestockMenu(restock []Restock, menu *[]entity.Menu, userID uuid.UUID) error
+	ResetStock(canteen *entity.Canteen, date string) error
+	UpdateOrder(order *entity.Order, userID uuid.UUID, loyaltyRate uint32) error
+	CancelOrder(order *entity.Order) error
+	RejectOrder(order *entity.Order, refund *entity.Refund, userID uuid.UUID) error
+	ExpireOrder(order *entity.Order) error
+	ExpirePoints(entry *entity.LoyaltyEntry) error
 	GetCanteenInfo(canteen *entity.Canteen) error
 	GetCanteenList(canteen *[]entity.Canteen) error
+	GetStockResetCanteenList(canteen *[]entity.Canteen) error
 	GetMenuInfo(menu *entity.Menu) error
+	GetOwnedCanteen(canteen *entity.Canteen, userID uuid.UUID) error
+	GetOwnedMenu(menu *entity.Menu, userID uuid.UUID) error
+	GetMenuCategoryList(category *[]entity.MenuCategory, canteenID uuid.UUID) error
+	GetCanteenMenu(menu *[]entity.Menu, canteenID uuid.UUID) error
+	GetMenuList(menu *[]entity.Menu, total *int64, filter MenuFilter) error
+	GetPromotionList(promotion *[]entity.Promotion, menuID uuid.UUID) error
+	GetModifierGroupList(group *[]entity.ModifierGroup, menuID uuid.UUID) error
+	GetMenuAvailabilityList(availability *[]entity.MenuAvailability, menuID uuid.UUID) error
 	GetOrderInfo(order *entity.Order) error
 	GetOrderList(order *[]entity.Order, userID uuid.UUID) error
+	GetPayment(payment *entity.Payment) error
+	GetPendingOrderPaymentList(payment *[]entity.Payment, orderID uuid.UUID) error
+	GetSettledOrderPayment(payment *entity.Payment) error
+	GetPaymentNotification(notification *entity.PaymentNotification) error
+	GetPaymentNotificationList(notification *[]entity.PaymentNotification) error
+	GetRefundList(refund *[]entity.Refund, userID uuid.UUID) error
+	GetPendingRefundList(refund *[]entity.Refund, createdBefore time.Time) error
+	GetPendingPaymentList(payment *[]entity.Payment) error
+	GetPayout(payout *entity.Payout, userID uuid.UUID) error
+	GetPayoutList(payout *[]entity.Payout, userID uuid.UUID) error
+	GetVoucherList(voucher *[]entity.Voucher, userID uuid.UUID) error
+	GetReconciliationReport(report *entity.ReconciliationReport) error
+	GetReconciliationReportList(report *[]entity.ReconciliationReport) error
+	GetUnpaidOrderList(order *[]entity.Order, createdBefore time.Time) error
+	GetExpiredPointsList(entry *[]entity.LoyaltyEntry, earnedBefore time.Time) error
 	GetFeedback(feedback *entity.Feedback) error
 	SoftDeleteMenu(menu *entity.Menu, userID uuid.UUID) error
+	SoftDeleteMenuCategory(category *entity.MenuCategory, userID uuid.UUID) error
 	SoftDeleteFeedback(feedback *entity.Feedback, userID uuid.UUID) error
+	SoftDeleteVoucher(voucher *entity.Voucher, userID uuid.UUID) error
+	SoftDeletePromotion(promotion *entity.Promotion, menu *entity.Menu, userID uuid.UUID) error
+	SoftDeleteModifierGroup(group *entity.ModifierGroup, menu *entity.Menu, userID uuid.UUID) error
+	SoftDeleteMenuAvailability(availability *entity.MenuAvailability, menu *entity.Menu, userID uuid.UUID) error
 }
 
 type CanteenDB struct {
@@ -55,195 +162,2368 @@ func (r *CanteenDB) CreateMenu(menu *entity.Menu, userID uuid.UUID) error {
 		return gorm.ErrRecordNotFound
 	}
 
+	if menu.CategoryID != uuid.Nil {
+		r.db.Debug().
+			Model(&entity.MenuCategory{}).
+			Where("id = ?", menu.CategoryID).
+			Where("canteen_id = ?", menu.CanteenID).
+			Count(&count)
+
+		if count == 0 {
+			return ErrInvalidCategory
+		}
+	}
+
+	if menu.Type == menutype.Bundle {
+		menuIDs := make([]uuid.UUID, len(menu.Components))
+
+		for i, component := range menu.Components {
+			menuIDs[i] = component.MenuID
+		}
+
+		r.db.Debug().
+			Model(&entity.Menu{}).
+			Where("id IN ?", menuIDs).
+			Where("canteen_id = ?", menu.CanteenID).
+			Where("type <> ?", menutype.Bundle).
+			Count(&count)
+
+		if count != int64(len(menuIDs)) {
+			return ErrInvalidComponents
+		}
+	}
+
 	return r.db.Debug().
 		Create(menu).
 		Error
 }
 
-func (r *CanteenDB) CreateOrder(menu *entity.Menu, order *entity.Order) error {
-	sub := r.db.Debug().
-		Model(&menu).
-		Select("id, canteen_id, stock").
-		Where("stock >= ?", order.Quantity).
-		First(&menu)
-	if sub == nil {
-		return gorm.ErrInvalidValue
-	}
-
-	remainingStock := menu.Stock - order.Quantity
-	menu.Stock = remainingStock
+func (r *CanteenDB) CreateMenuCategory(category *entity.MenuCategory, userID uuid.UUID) error {
+	var count int64
 
 	r.db.Debug().
-		Create(order)
+		Model(&entity.Canteen{}).
+		Where("id = ?", category.CanteenID).
+		Where("user_id = ?", userID).
+		Count(&count)
+
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
 
 	return r.db.Debug().
-		Model(&menu).
-		Where("id = ?", menu.ID).
-		Updates(menu).
+		Create(category).
 		Error
 }
 
+func (r *CanteenDB) CreateOrder(order *entity.Order, now time.Time, price func(order *entity.Order)) error {
+	slices.SortFunc(order.OrderItems, func(a entity.OrderItem, b entity.OrderItem) int {
+		return strings.Compare(a.MenuID.String(), b.MenuID.String())
+	})
+
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		for i := range order.OrderItems {
+			var menu entity.Menu
+
+			item := &order.OrderItems[i]
+
+			err := tx.Preload("Promotions", currentPromotions(now)).
+				Preload("Modifiers.Options").
+				Preload("Components").
+				Preload("Availabilities").
+				Select("id, canteen_id, name, type, price").
+				Where("id = ?", item.MenuID).
+				Where("canteen_id = ?", order.CanteenID).
+				First(&menu).
+				Error
+			if err != nil {
+				return err
+			}
+
+			if !menu.Available(now) {
+				return ErrMenuUnavailable
+			}
+
+			err = takeStock(tx, &menu, item)
+			if err != nil {
+				return err
+			}
+
+			err = selectOptions(tx, &menu, item, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		if order.VoucherCode != "" {
+			err := redeemVoucher(tx, order)
+			if err != nil {
+				return err
+			}
+		}
+
+		price(order)
+
+		return tx.Create(order).Error
+	})
+}
+
 func (r *CanteenDB) CreatePayment(payment *entity.Payment) error {
 	return r.db.Debug().
 		Create(payment).
 		Error
 }
 
-func (r *CanteenDB) VerifyPayment(order *entity.Order) error {
-	return r.db.Debug().
-		Model(&entity.Order{}).
-		Where("id = ?", order.ID).
-		Update("status = ?", "PAID").
-		Error
+func (r *CanteenDB) ApplyVoucher(order *entity.Order, price func(order *entity.Order)) error {
+	code := order.VoucherCode
+
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("OrderItems").
+			Where("id = ?", order.ID).
+			Where("user_id = ?", order.UserID).
+			First(order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if order.Status != orderstatus.Unpaid {
+			return &orderstatus.TransitionError{
+				From: order.Status,
+				To:   orderstatus.Paid,
+			}
+		}
+
+		if order.VoucherCode == code {
+			return nil
+		}
+
+		if order.VoucherCode != "" {
+			return ErrVoucherAlreadyApplied
+		}
+
+		order.VoucherCode = code
+
+		err = redeemVoucher(tx, order)
+		if err != nil {
+			return err
+		}
+
+		price(order)
+
+		return tx.Model(order).
+			Select("voucher_code", "discount", "service_fee", "tax", "rounding", "total").
+			Updates(order).
+			Error
+	})
 }
 
-func (r *CanteenDB) CreateFeedback(feedback *entity.Feedback) error {
+func (r *CanteenDB) UsePoints(order *entity.Order, price func(order *entity.Order)) error {
+	points := order.PointsUsed
+
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("OrderItems").
+			Where("id = ?", order.ID).
+			Where("user_id = ?", order.UserID).
+			First(order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if order.Status != orderstatus.Unpaid {
+			return &orderstatus.TransitionError{
+				From: order.Status,
+				To:   orderstatus.Paid,
+			}
+		}
+
+		if order.PointsUsed != 0 {
+			if order.PointsUsed == points {
+				return nil
+			}
+
+			return ErrPointsAlreadyUsed
+		}
+
+		order.PointsUsed = min(points, order.CalculateSubtotal()-order.Discount)
+
+		if order.PointsUsed == 0 {
+			return nil
+		}
+
+		err = debitPoints(tx, order.UserID, order.ID, order.PointsUsed)
+		if err != nil {
+			return err
+		}
+
+		price(order)
+
+		return tx.Model(order).
+			Select("points_used", "service_fee", "tax", "rounding", "total").
+			Updates(order).
+			Error
+	})
+}
+
+func (r *CanteenDB) PayWithWallet(payment *entity.Payment) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		var order entity.Order
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", payment.OrderID).
+			Where("user_id = ?", payment.UserID).
+			First(&order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = transitionOrder(tx, &order, orderstatus.Paid, payment.UserID)
+		if err != nil {
+			return err
+		}
+
+		err = debitWallet(tx, payment.UserID, payment.Price)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		payment.Status = paymentstatus.Settled
+		payment.SettledAt = &now
+
+		err = tx.Create(payment).Error
+		if err != nil {
+			return err
+		}
+
+		return postLedger(
+			tx,
+			payment.ID,
+			ledger.WalletAccount(payment.UserID),
+			ledger.CanteenAccount(order.CanteenID),
+			payment.Price,
+			"order payment",
+		)
+	})
+}
+
+func (r *CanteenDB) PayWithCash(payment *entity.Payment) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		var order entity.Order
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", payment.OrderID).
+			Where("user_id = ?", payment.UserID).
+			First(&order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = transitionOrder(tx, &order, orderstatus.AwaitingCash, payment.UserID)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(payment).Error
+	})
+}
+
+func (r *CanteenDB) ConfirmCashPayment(payment *entity.Payment, userID uuid.UUID) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		var order entity.Order
+
+		sub := tx.Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", payment.OrderID).
+			Where("canteen_id IN (?)", sub).
+			First(&order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ?", order.ID).
+			Where("method = ?", paymentmethod.Cash).
+			Where("status = ?", paymentstatus.Pending).
+			Order("created_at DESC").
+			First(payment).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = transitionOrder(tx, &order, orderstatus.Paid, userID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		payment.Status = paymentstatus.Settled
+		payment.ConfirmedBy = userID
+		payment.SettledAt = &now
+
+		return tx.Model(&entity.Payment{}).
+			Where("id = ?", payment.ID).
+			Updates(map[string]any{
+				"status":       payment.Status,
+				"confirmed_by": payment.ConfirmedBy,
+				"settled_at":   payment.SettledAt,
+			}).
+			Error
+	})
+}
+
+func (r *CanteenDB) VerifyPayment(payment *entity.Payment, refund *entity.Refund) error {
+	status := payment.Status
+	transactionID := payment.TransactionID
+
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", payment.ID).
+			First(payment).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if !status.Supersedes(payment.Status) {
+			return nil
+		}
+
+		updates := map[string]any{
+			"status":         status,
+			"transaction_id": transactionID,
+		}
+
+		if status == paymentstatus.Settled {
+			now := time.Now()
+
+			payment.SettledAt = &now
+			updates["settled_at"] = payment.SettledAt
+		}
+
+		err = tx.Model(&entity.Payment{}).
+			Where("id = ?", payment.ID).
+			Updates(updates).
+			Error
+		if err != nil {
+			return err
+		}
+
+		payment.Status = status
+		payment.TransactionID = transactionID
+
+		if status != paymentstatus.Settled {
+			return nil
+		}
+
+		if payment.Purpose == paymentmethod.TopUp {
+			err = creditWallet(tx, payment.UserID, payment.Price)
+			if err != nil {
+				return err
+			}
+
+			return postLedger(
+				tx,
+				payment.ID,
+				ledger.GatewayAccount,
+				ledger.WalletAccount(payment.UserID),
+				payment.Price,
+				"wallet top-up",
+			)
+		}
+
+		var order entity.Order
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", payment.OrderID).
+			First(&order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if order.Status == orderstatus.Unpaid || order.Status == orderstatus.AwaitingCash {
+			return transitionOrder(tx, &order, orderstatus.Paid, uuid.Nil)
+		}
+
+		refund.OrderID = order.ID
+
+		return reserveRefund(tx, refund, nil, payment)
+	})
+}
+
+func (r *CanteenDB) CreatePaymentNotification(notification *entity.PaymentNotification) error {
 	res := r.db.Debug().
-		Model(&entity.Order{}).
-		Where("id = ?", feedback.OrderID).
-		Where("user_id = ?", feedback.UserID).
-		Where("status = ?", "COMPLETED").
-		Update("status", "FEEDBACKSENT")
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(notification)
+	if res.Error != nil {
+		return res.Error
+	}
 
 	if res.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+		return ErrDuplicateNotification
 	}
 
+	return nil
+}
+
+func (r *CanteenDB) UpdatePaymentNotification(notification *entity.PaymentNotification) error {
 	return r.db.Debug().
-		Create(feedback).
+		Model(&entity.PaymentNotification{}).
+		Where("id = ?", notification.ID).
+		Update("processed", notification.Processed).
 		Error
 }
 
-func (r *CanteenDB) UpdateMenu(menu *entity.Menu, userID uuid.UUID) error {
-	sub := r.db.Debug().
-		Model(&entity.Canteen{}).
-		Select("id").
-		Where("user_id = ?", userID)
+func (r *CanteenDB) CreateReconciliationReport(report *entity.ReconciliationReport) error {
+	return r.db.Debug().
+		Create(report).
+		Error
+}
 
-	res := r.db.Debug().
-		Where("id = ?", menu.ID).
-		Where("canteen_id IN (?)", sub).
-		Updates(menu)
+func (r *CanteenDB) CreatePayout(payout *entity.Payout) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		var payments []entity.Payment
 
-	if res.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
-	}
+		orderSub := tx.Model(&entity.Order{}).
+			Select("id").
+			Where("canteen_id = ?", payout.CanteenID)
 
-	return res.Error
+		itemSub := tx.Model(&entity.PayoutItem{}).
+			Select("payment_id")
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id IN (?)", orderSub).
+			Where("id NOT IN (?)", itemSub).
+			Where("purpose <> ?", paymentmethod.TopUp).
+			Where("status IN ?", []paymentstatus.Status{paymentstatus.Settled, paymentstatus.Refunded}).
+			Where("settled_at >= ?", payout.PeriodStart).
+			Where("settled_at < ?", payout.PeriodEnd).
+			Find(&payments).
+			Error
+		if err != nil {
+			return err
+		}
+
+		adjustmentSub := tx.Model(&entity.PayoutAdjustment{}).
+			Select("refund_id")
+
+		err = tx.Model(&entity.Refund{}).
+			Select("refunds.id AS refund_id, refunds.payment_id, refunds.order_id, payout_items.method, refunds.amount, refunds.completed_at AS refund_date").
+			Joins("JOIN payout_items ON payout_items.payment_id = refunds.payment_id").
+			Where("refunds.order_id IN (?)", orderSub).
+			Where("refunds.status = ?", refundstatus.Completed).
+			Where("refunds.completed_at > payout_items.created_at").
+			Where("refunds.completed_at < ?", payout.PeriodEnd).
+			Where("refunds.id NOT IN (?)", adjustmentSub).
+			Scan(&payout.Adjustments).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if len(payments) == 0 && len(payout.Adjustments) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		for i := range payout.Adjustments {
+			payout.Adjustments[i].ID = uuid.New()
+			payout.Adjustments[i].PayoutID = payout.ID
+			payout.Adjustments[i].Amount = -payout.Adjustments[i].Amount
+		}
+
+		payout.Items = make([]entity.PayoutItem, len(payments))
+
+		for i, p := range payments {
+			payout.Items[i] = entity.PayoutItem{
+				ID:             uuid.New(),
+				PayoutID:       payout.ID,
+				PaymentID:      p.ID,
+				OrderID:        p.OrderID,
+				Method:         p.Method,
+				Amount:         p.Price,
+				RefundedAmount: p.RefundedAmount,
+				PaymentDate:    *p.SettledAt,
+			}
+		}
+
+		payout.CalculateNet()
+
+		return tx.Create(payout).Error
+	})
 }
 
-func (r *CanteenDB) UpdateOrder(order *entity.Order, userID uuid.UUID) error {
-	res := r.db.Debug().
-		Model(&entity.Order{}).
-		Where("id = ?", order.ID).
-		Where("status IN ?", []string{"PAID", "COOKING"}).
-		Updates(order)
+func (r *CanteenDB) UpdatePayoutPaid(payout *entity.Payout) error {
+	paidBy := payout.PaidBy
 
-	if res.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
-	}
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", payout.ID).
+			First(payout).
+			Error
+		if err != nil {
+			return err
+		}
 
-	return r.db.Debug().Debug().
-		Select("canteen_id, user_id, menu_id, quantity, created_at, updated_at").
-		First(&order).
-		Error
+		if payout.Status == settlement.Paid {
+			return ErrPayoutAlreadyPaid
+		}
+
+		paidAt := time.Now()
+
+		payout.Status = settlement.Paid
+		payout.PaidBy = paidBy
+		payout.PaidAt = &paidAt
+
+		return tx.Model(&entity.Payout{}).
+			Where("id = ?", payout.ID).
+			Updates(map[string]any{
+				"status":  payout.Status,
+				"paid_by": payout.PaidBy,
+				"paid_at": payout.PaidAt,
+			}).
+			Error
+	})
 }
 
-func (r *CanteenDB) GetCanteenList(canteen *[]entity.Canteen) error {
+func (r *CanteenDB) ReserveRefund(refund *entity.Refund, ownerID uuid.UUID) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		var order entity.Order
+		var payment entity.Payment
+
+		query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", refund.OrderID)
+
+		if ownerID != uuid.Nil {
+			sub := tx.Model(&entity.Canteen{}).
+				Select("id").
+				Where("user_id = ?", ownerID)
+
+			query = query.Where("canteen_id IN (?)", sub)
+		}
+
+		err := query.First(&order).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ?", order.ID).
+			Where("status = ?", paymentstatus.Settled).
+			Order("created_at DESC").
+			First(&payment).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return reserveRefund(tx, refund, &order, &payment)
+	})
+}
+
+func (r *CanteenDB) CompleteRefund(refund *entity.Refund) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		var order entity.Order
+		var payment entity.Payment
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", refund.ID).
+			Where("status = ?", refundstatus.Pending).
+			First(refund).
+			Error
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", refund.PaymentID).
+			First(&payment).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", refund.OrderID).
+			First(&order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if payment.Method == paymentmethod.Wallet {
+			err = refundToWallet(tx, &payment, order.CanteenID, refund.Amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		status := orderstatus.PartiallyRefunded
+		paymentStatus := paymentstatus.Settled
+
+		if payment.RefundedAmount+refund.Amount == payment.Price {
+			status = orderstatus.Refunded
+			paymentStatus = paymentstatus.Refunded
+		}
+
+		err = tx.Model(&entity.Payment{}).
+			Where("id = ?", payment.ID).
+			Updates(map[string]any{
+				"refunded_amount": payment.RefundedAmount + refund.Amount,
+				"status":          paymentStatus,
+			}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if order.Status.CanTransitionTo(status) {
+			err = transitionOrder(tx, &order, status, refund.RequestedBy)
+			if err != nil {
+				return err
+			}
+
+			if status == orderstatus.Refunded {
+				err = releaseVoucher(tx, &order)
+				if err != nil {
+					return err
+				}
+
+				err = restorePoints(tx, &order)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		now := time.Now()
+
+		refund.Status = refundstatus.Completed
+		refund.CompletedAt = &now
+
+		return tx.Model(&entity.Refund{}).
+			Where("id = ?", refund.ID).
+			Updates(map[string]any{
+				"status":       refund.Status,
+				"completed_at": refund.CompletedAt,
+			}).
+			Error
+	})
+}
+
+func (r *CanteenDB) UpdateRefundAttempt(refund *entity.Refund) error {
+	refund.Attempts++
+
 	return r.db.Debug().
-		Model(&canteen).
-		Select("id, name").
-		Find(canteen).
+		Model(&entity.Refund{}).
+		Where("id = ?", refund.ID).
+		Update("attempts", gorm.Expr("attempts + 1")).
 		Error
 }
 
-func (r *CanteenDB) GetCanteenInfo(canteen *entity.Canteen) error {
+func (r *CanteenDB) CreateFeedback(feedback *entity.Feedback) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		var order entity.Order
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", feedback.OrderID).
+			Where("user_id = ?", feedback.UserID).
+			First(&order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = transitionOrder(tx, &order, orderstatus.FeedbackSent, feedback.UserID)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(feedback).Error
+	})
+}
+
+func (r *CanteenDB) CreateVoucher(voucher *entity.Voucher, userID uuid.UUID) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		var count int64
+
+		err := tx.Unscoped().
+			Model(&entity.Voucher{}).
+			Where("code = ?", voucher.Code).
+			Count(&count).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if count != 0 {
+			return ErrDuplicateVoucherCode
+		}
+
+		if voucher.CanteenID != uuid.Nil {
+			query := tx.Model(&entity.Canteen{}).
+				Where("id = ?", voucher.CanteenID)
+
+			if userID != uuid.Nil {
+				query = query.Where("user_id = ?", userID)
+			}
+
+			err = query.Count(&count).Error
+			if err != nil {
+				return err
+			}
+
+			if count == 0 {
+				return gorm.ErrRecordNotFound
+			}
+		}
+
+		if voucher.MenuID != uuid.Nil {
+			err = tx.Model(&entity.Menu{}).
+				Where("id = ?", voucher.MenuID).
+				Where("canteen_id = ?", voucher.CanteenID).
+				Count(&count).
+				Error
+			if err != nil {
+				return err
+			}
+
+			if count == 0 {
+				return gorm.ErrRecordNotFound
+			}
+		}
+
+		return tx.Create(voucher).Error
+	})
+}
+
+func (r *CanteenDB) CreatePromotion(promotion *entity.Promotion, menu *entity.Menu, userID uuid.UUID) error {
+	err := ownerMenu(r.db.Debug(), menu, promotion.MenuID, userID)
+	if err != nil {
+		return err
+	}
+
 	return r.db.Debug().
-		Select("id, user_id, name, created_at, updated_at").
-		First(canteen).
+		Create(promotion).
 		Error
 }
 
-func (r *CanteenDB) GetMenuInfo(menu *entity.Menu) error {
+func (r *CanteenDB) CreateMenuAvailability(availability *entity.MenuAvailability, menu *entity.Menu, userID uuid.UUID) error {
+	err := ownerMenu(r.db.Debug(), menu, availability.MenuID, userID)
+	if err != nil {
+		return err
+	}
+
 	return r.db.Debug().
-		Select("id, canteen_id, name, price, stock, created_at, updated_at").
-		First(&menu).
+		Create(availability).
 		Error
 }
 
-func (r *CanteenDB) GetOrderInfo(order *entity.Order) error {
+func (r *CanteenDB) CreateModifierGroup(group *entity.ModifierGroup, menu *entity.Menu, userID uuid.UUID) error {
+	err := ownerMenu(r.db.Debug(), menu, group.MenuID, userID)
+	if err != nil {
+		return err
+	}
+
 	return r.db.Debug().
-		Select("id, canteen_id, user_id, menu_id, quantity, status, created_at, updated_at").
-		Where("user_id = ?", order.UserID).
-		First(&order).
+		Create(group).
 		Error
 }
 
-func (r *CanteenDB) GetOrderList(order *[]entity.Order, userID uuid.UUID) error {
+func (r *CanteenDB) UpdateCanteen(canteen *entity.Canteen, userID uuid.UUID) error {
+	update := *canteen
+
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", canteen.ID).
+			Where("user_id = ?", userID).
+			First(canteen).
+			Error
+		if err != nil {
+			return err
+		}
+
+		canteen.Name = update.Name
+		canteen.AllowCashPayment = update.AllowCashPayment
+		canteen.ServiceFeeRate = update.ServiceFeeRate
+		canteen.TaxRate = update.TaxRate
+		canteen.RoundingUnit = update.RoundingUnit
+		canteen.LoyaltyRate = update.LoyaltyRate
+		canteen.Timezone = update.Timezone
+		canteen.StockResetTime = update.StockResetTime
+
+		return tx.Model(canteen).
+			Select("name", "allow_cash_payment", "service_fee_rate", "tax_rate", "rounding_unit", "loyalty_rate", "timezone", "stock_reset_time").
+			Updates(canteen).
+			Error
+	})
+}
+
+func (r *CanteenDB) UpdateMenu(menu *entity.Menu, userID uuid.UUID) error {
 	sub := r.db.Debug().
 		Model(&entity.Canteen{}).
 		Select("id").
 		Where("user_id = ?", userID)
 
+	if menu.CategoryID != uuid.Nil {
+		var count int64
+
+		r.db.Debug().
+			Model(&entity.MenuCategory{}).
+			Where("id = ?", menu.CategoryID).
+			Where("canteen_id = (?)", r.db.Debug().
+				Model(&entity.Menu{}).
+				Select("canteen_id").
+				Where("id = ?", menu.ID)).
+			Count(&count)
+
+		if count == 0 {
+			return ErrInvalidCategory
+		}
+	}
+
 	res := r.db.Debug().
-		Model(&entity.Order{}).
-		Select("id, canteen_id, user_id, menu_id, quantity, status, created_at, updated_at").
+		Where("id = ?", menu.ID).
 		Where("canteen_id IN (?)", sub).
-		Find(order)
+		Updates(menu)
+
+	if res.Error != nil {
+		return res.Error
+	}
 
 	if res.RowsAffected == 0 {
 		return gorm.ErrRecordNotFound
 	}
 
-	return res.Error
-}
-
-func (r *CanteenDB) GetFeedback(feedback *entity.Feedback) error {
 	return r.db.Debug().
-		Select("id, order_id, user_id, content, created_at, updated_at").
-		Where("id = ?", feedback.ID).
-		First(&feedback).
+		Select("canteen_id").
+		Where("id = ?", menu.ID).
+		First(menu).
 		Error
 }
 
-func (r *CanteenDB) SoftDeleteMenu(menu *entity.Menu, userID uuid.UUID) error {
+func (r *CanteenDB) UpdateMenuCategory(category *entity.MenuCategory, userID uuid.UUID) error {
 	sub := r.db.Debug().
 		Model(&entity.Canteen{}).
 		Select("id").
 		Where("user_id = ?", userID)
 
 	res := r.db.Debug().
-		Where("id = ?", menu.ID).
+		Where("id = ?", category.ID).
 		Where("canteen_id IN (?)", sub).
-		Delete(menu)
+		Updates(category)
+	if res.Error != nil {
+		return res.Error
+	}
 
 	if res.RowsAffected == 0 {
 		return gorm.ErrRecordNotFound
 	}
 
-	return res.Error
+	return r.db.Debug().
+		Where("id = ?", category.ID).
+		First(category).
+		Error
 }
 
-func (r *CanteenDB) SoftDeleteFeedback(feedback *entity.Feedback, userID uuid.UUID) error {
-	canteenSub := r.db.Debug().
-		Model(&entity.Canteen{}).
-		Select("id").
-		Where("user_id = ?", userID)
+func (r *CanteenDB) UpdateModifierOption(option *entity.ModifierOption, menu *entity.Menu, userID uuid.UUID) error {
+	sub := r.db.Debug().
+		Model(&entity.ModifierGroup{}).
+		Select("menu_id").
+		Where("id IN (?)", r.db.Debug().
+			Model(&entity.ModifierOption{}).
+			Select("group_id").
+			Where("id = ?", option.ID))
 
-	orderSub := r.db.Debug().
-		Model(&entity.Order{}).
-		Select("id").
-		Where("status = ?", "FEEDBACKSENT").
-		Where("canteen_id IN (?)", canteenSub)
+	err := ownerMenu(r.db.Debug(), menu, sub, userID)
+	if err != nil {
+		return err
+	}
 
 	res := r.db.Debug().
-		Where("id = ?", feedback.ID).
-		Where("order_id IN (?)", orderSub).
-		Delete(feedback)
+		Where("id = ?", option.ID).
+		Where("group_id IN (?)", ownedModifierGroups(r.db.Debug(), userID)).
+		Updates(option)
+	if res.Error != nil {
+		return res.Error
+	}
 
 	if res.RowsAffected == 0 {
 		return gorm.ErrRecordNotFound
 	}
 
-	return res.Error
+	return nil
+}
+
+func (r *CanteenDB) RestockMenu(restock []Restock, menu *[]entity.Menu, userID uuid.UUID) error {
+	menuIDs := make([]uuid.UUID, len(restock))
+
+	for i, item := range restock {
+		menuIDs[i] = item.MenuID
+	}
+
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Where("id IN ?", menuIDs).
+			Where("type <> ?", menutype.Bundle).
+			Where("canteen_id IN (?)", r.db.Debug().
+				Model(&entity.Canteen{}).
+				Select("id").
+				Where("user_id = ?", userID)).
+			Find(menu).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if len(*menu) != len(menuIDs) {
+			return gorm.ErrRecordNotFound
+		}
+
+		for _, item := range restock {
+			update := map[string]any{
+				"stock": item.Stock,
+			}
+
+			if item.ParLevel != nil {
+				update["par_level"] = *item.ParLevel
+			}
+
+			err = tx.Model(&entity.Menu{}).
+				Where("id = ?", item.MenuID).
+				Updates(update).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Select("id, canteen_id, category_id, name, type, position, price, stock, par_level, image, thumbnail, created_at, updated_at").
+			Where("id IN ?", menuIDs).
+			Order("position, name").
+			Find(menu).
+			Error
+	})
+}
+
+func (r *CanteenDB) ResetStock(canteen *entity.Canteen, date string) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&entity.Canteen{}).
+			Where("id = ?", canteen.ID).
+			Where("last_stock_reset IS NULL OR last_stock_reset <> ?", date).
+			Update("last_stock_reset", date)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		canteen.LastStockReset = date
+
+		return tx.Model(&entity.Menu{}).
+			Where("canteen_id = ?", canteen.ID).
+			Where("par_level > 0").
+			Where("type <> ?", menutype.Bundle).
+			Update("stock", gorm.Expr("par_level")).
+			Error
+	})
+}
+
+func (r *CanteenDB) UpdateOrder(order *entity.Order, userID uuid.UUID, loyaltyRate uint32) error {
+	status := order.Status
+
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		sub := tx.Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("OrderItems").
+			Where("id = ?", order.ID).
+			Where("canteen_id IN (?)", sub).
+			First(order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = transitionOrder(tx, order, status, userID)
+		if err != nil {
+			return err
+		}
+
+		if status != orderstatus.Completed {
+			return nil
+		}
+
+		return earnPoints(tx, order, loyaltyRate)
+	})
+}
+
+func (r *CanteenDB) CancelOrder(order *entity.Order) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("OrderItems").
+			Where("id = ?", order.ID).
+			Where("user_id = ?", order.UserID).
+			First(order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if order.Status != orderstatus.Unpaid && order.Status != orderstatus.AwaitingCash {
+			return &orderstatus.TransitionError{
+				From: order.Status,
+				To:   orderstatus.Cancelled,
+			}
+		}
+
+		err = transitionOrder(tx, order, orderstatus.Cancelled, order.UserID)
+		if err != nil {
+			return err
+		}
+
+		err = closeCashPayment(tx, order, paymentstatus.Failed)
+		if err != nil {
+			return err
+		}
+
+		err = releaseVoucher(tx, order)
+		if err != nil {
+			return err
+		}
+
+		err = restorePoints(tx, order)
+		if err != nil {
+			return err
+		}
+
+		return restoreStock(tx, order)
+	})
+}
+
+func (r *CanteenDB) RejectOrder(order *entity.Order, refund *entity.Refund, userID uuid.UUID) error {
+	reason := order.Reason
+
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		sub := tx.Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("OrderItems").
+			Where("id = ?", order.ID).
+			Where("canteen_id IN (?)", sub).
+			First(order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if order.Status == orderstatus.Unpaid {
+			return &orderstatus.TransitionError{
+				From: order.Status,
+				To:   orderstatus.Cancelled,
+			}
+		}
+
+		err = transitionOrder(tx, order, orderstatus.Cancelled, userID)
+		if err != nil {
+			return err
+		}
+
+		order.Reason = reason
+
+		err = tx.Model(&entity.Order{}).
+			Where("id = ?", order.ID).
+			Update("reason", reason).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = closeCashPayment(tx, order, paymentstatus.Failed)
+		if err != nil {
+			return err
+		}
+
+		err = releaseVoucher(tx, order)
+		if err != nil {
+			return err
+		}
+
+		err = restorePoints(tx, order)
+		if err != nil {
+			return err
+		}
+
+		err = restoreStock(tx, order)
+		if err != nil {
+			return err
+		}
+
+		var payment entity.Payment
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ?", order.ID).
+			Where("status = ?", paymentstatus.Settled).
+			Order("created_at DESC").
+			First(&payment).
+			Error
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return reserveRefund(tx, refund, order, &payment)
+	})
+}
+
+func (r *CanteenDB) ExpireOrder(order *entity.Order) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("OrderItems").
+			Where("id = ?", order.ID).
+			First(order).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = transitionOrder(tx, order, orderstatus.Expired, uuid.Nil)
+		if err != nil {
+			return err
+		}
+
+		err = closeCashPayment(tx, order, paymentstatus.Expired)
+		if err != nil {
+			return err
+		}
+
+		err = releaseVoucher(tx, order)
+		if err != nil {
+			return err
+		}
+
+		err = restorePoints(tx, order)
+		if err != nil {
+			return err
+		}
+
+		return restoreStock(tx, order)
+	})
+}
+
+func (r *CanteenDB) ExpirePoints(entry *entity.LoyaltyEntry) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", entry.ID).
+			Where("remaining > 0").
+			First(entry).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&entity.LoyaltyAccount{}).
+			Where("user_id = ?", entry.UserID).
+			Update("balance", gorm.Expr("balance - ?", entry.Remaining)).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&entity.LoyaltyEntry{
+			ID:      uuid.New(),
+			UserID:  entry.UserID,
+			OrderID: entry.OrderID,
+			Kind:    loyalty.Expire,
+			Points:  -int32(entry.Remaining),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		entry.Remaining = 0
+
+		return tx.Model(entry).
+			Update("remaining", 0).
+			Error
+	})
+}
+
+func (r *CanteenDB) GetCanteenList(canteen *[]entity.Canteen) error {
+	return r.db.Debug().
+		Model(&canteen).
+		Select("id, name").
+		Find(canteen).
+		Error
+}
+
+func (r *CanteenDB) GetStockResetCanteenList(canteen *[]entity.Canteen) error {
+	return r.db.Debug().
+		Select("id, timezone, stock_reset_time, last_stock_reset").
+		Where("stock_reset_time <> ''").
+		Find(canteen).
+		Error
+}
+
+func (r *CanteenDB) GetCanteenInfo(canteen *entity.Canteen) error {
+	return r.db.Debug().
+		Select("id, user_id, name, allow_cash_payment, service_fee_rate, tax_rate, rounding_unit, loyalty_rate, timezone, stock_reset_time, created_at, updated_at").
+		First(canteen).
+		Error
+}
+
+func (r *CanteenDB) GetMenuInfo(menu *entity.Menu) error {
+	return r.db.Debug().
+		Preload("Promotions", currentPromotions(time.Now())).
+		Preload("Modifiers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, name")
+		}).
+		Preload("Modifiers.Options", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, name")
+		}).
+		Preload("Components.Menu").
+		Preload("Availabilities").
+		Select("id, canteen_id, category_id, name, type, position, price, stock, par_level, image, thumbnail, created_at, updated_at").
+		First(&menu).
+		Error
+}
+
+func (r *CanteenDB) GetOwnedCanteen(canteen *entity.Canteen, userID uuid.UUID) error {
+	return r.db.Debug().
+		Where("id = ?", canteen.ID).
+		Where("user_id = ?", userID).
+		First(canteen).
+		Error
+}
+
+func (r *CanteenDB) GetOwnedMenu(menu *entity.Menu, userID uuid.UUID) error {
+	return ownerMenu(r.db.Debug(), menu, menu.ID, userID)
+}
+
+func (r *CanteenDB) GetMenuCategoryList(category *[]entity.MenuCategory, canteenID uuid.UUID) error {
+	return r.db.Debug().
+		Where("canteen_id = ?", canteenID).
+		Order("position, name").
+		Find(category).
+		Error
+}
+
+func (r *CanteenDB) GetCanteenMenu(menu *[]entity.Menu, canteenID uuid.UUID) error {
+	return r.db.Debug().
+		Preload("Promotions", currentPromotions(time.Now())).
+		Preload("Components.Menu").
+		Preload("Availabilities").
+		Select("id, canteen_id, category_id, name, type, position, price, stock, par_level, image, thumbnail, created_at, updated_at").
+		Where("canteen_id = ?", canteenID).
+		Order("position, name").
+		Find(menu).
+		Error
+}
+
+func (r *CanteenDB) GetMenuList(menu *[]entity.Menu, total *int64, filter MenuFilter) error {
+	sold := r.db.Debug().
+		Model(&entity.OrderItem{}).
+		Select("order_items.menu_id, SUM(order_items.quantity) AS quantity").
+		Joins("JOIN orders ON orders.id = order_items.order_id").
+		Where("orders.status IN ?", []orderstatus.Status{
+			orderstatus.Paid,
+			orderstatus.Waiting,
+			orderstatus.Cooking,
+			orderstatus.Ready,
+			orderstatus.Completed,
+			orderstatus.FeedbackSent,
+		}).
+		Group("order_items.menu_id")
+
+	query := r.db.Debug().
+		Model(&entity.Menu{}).
+		Joins("LEFT JOIN (?) AS sold ON sold.menu_id = menus.id", sold).
+		Where("menus.canteen_id = ?", filter.CanteenID)
+
+	if filter.InStock {
+		query = query.Where("(menus.stock > 0 OR (menus.type = ? AND NOT EXISTS (?)))", menutype.Bundle, r.db.Debug().
+			Model(&entity.BundleItem{}).
+			Select("1").
+			Joins("LEFT JOIN menus AS components ON components.id = bundle_items.menu_id AND components.deleted_at IS NULL").
+			Where("bundle_items.bundle_id = menus.id").
+			Where("components.id IS NULL OR components.stock < bundle_items.quantity"))
+	}
+
+	if filter.Available {
+		query = query.Where("NOT EXISTS (?) OR EXISTS (?)", r.db.Debug().
+			Model(&entity.MenuAvailability{}).
+			Select("1").
+			Where("menu_availabilities.menu_id = menus.id"), activeAvailabilities(r.db.Debug(), filter.Now))
+	}
+
+	if filter.MinPrice != 0 {
+		query = query.Where("menus.price >= ?", filter.MinPrice)
+	}
+
+	if filter.MaxPrice != 0 {
+		query = query.Where("menus.price <= ?", filter.MaxPrice)
+	}
+
+	query = query.Session(&gorm.Session{})
+
+	err := query.Count(total).Error
+	if err != nil {
+		return err
+	}
+
+	order := "menus.position, menus.name"
+
+	switch filter.Sort {
+	case "price":
+		order = "menus.price"
+	case "name":
+		order = "menus.name"
+	case "popularity":
+		order = "COALESCE(sold.quantity, 0)"
+	}
+
+	if filter.Sort != "" && filter.Descending {
+		order += " DESC"
+	}
+
+	return query.
+		Preload("Promotions", currentPromotions(time.Now())).
+		Preload("Components.Menu").
+		Preload("Availabilities").
+		Select("menus.id, menus.canteen_id, menus.category_id, menus.name, menus.type, menus.position, menus.price, menus.stock, menus.par_level, menus.image, menus.thumbnail, menus.created_at, menus.updated_at").
+		Order(order).
+		Order("menus.id").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(menu).
+		Error
+}
+
+func (r *CanteenDB) GetPromotionList(promotion *[]entity.Promotion, menuID uuid.UUID) error {
+	return r.db.Debug().
+		Scopes(currentPromotions(time.Now())).
+		Where("menu_id = ?", menuID).
+		Order("valid_from").
+		Find(promotion).
+		Error
+}
+
+func (r *CanteenDB) GetMenuAvailabilityList(availability *[]entity.MenuAvailability, menuID uuid.UUID) error {
+	return r.db.Debug().
+		Where("menu_id = ?", menuID).
+		Order("start_date, start_minute").
+		Find(availability).
+		Error
+}
+
+func (r *CanteenDB) GetModifierGroupList(group *[]entity.ModifierGroup, menuID uuid.UUID) error {
+	return r.db.Debug().
+		Preload("Options", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, name")
+		}).
+		Where("menu_id = ?", menuID).
+		Order("position, name").
+		Find(group).
+		Error
+}
+
+func (r *CanteenDB) GetOrderInfo(order *entity.Order) error {
+	return r.db.Debug().
+		Preload("OrderItems.Options").
+		Preload("Histories", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at")
+		}).
+		Select("id, canteen_id, user_id, subtotal, voucher_code, discount, points_used, service_fee, tax, rounding, total, status, receipt_number, paid_at, created_at, updated_at").
+		Where("user_id = ?", order.UserID).
+		First(&order).
+		Error
+}
+
+func (r *CanteenDB) GetOrderList(order *[]entity.Order, userID uuid.UUID) error {
+	sub := r.db.Debug().
+		Model(&entity.Canteen{}).
+		Select("id").
+		Where("user_id = ?", userID)
+
+	res := r.db.Debug().
+		Model(&entity.Order{}).
+		Preload("OrderItems.Options").
+		Select("id, canteen_id, user_id, subtotal, voucher_code, discount, points_used, service_fee, tax, rounding, total, status, created_at, updated_at").
+		Where("canteen_id IN (?)", sub).
+		Find(order)
+
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return res.Error
+}
+
+func (r *CanteenDB) GetPayment(payment *entity.Payment) error {
+	return r.db.Debug().
+		Where("id = ?", payment.ID).
+		First(payment).
+		Error
+}
+
+func (r *CanteenDB) GetPendingOrderPaymentList(payment *[]entity.Payment, orderID uuid.UUID) error {
+	return r.db.Debug().
+		Where("order_id = ?", orderID).
+		Where("status = ?", paymentstatus.Pending).
+		Find(payment).
+		Error
+}
+
+func (r *CanteenDB) GetSettledOrderPayment(payment *entity.Payment) error {
+	return r.db.Debug().
+		Where("order_id = ?", payment.OrderID).
+		Where("status IN ?", []paymentstatus.Status{paymentstatus.Settled, paymentstatus.Refunded}).
+		Order("created_at DESC").
+		First(payment).
+		Error
+}
+
+func (r *CanteenDB) GetUnpaidOrderList(order *[]entity.Order, createdBefore time.Time) error {
+	return r.db.Debug().
+		Select("id").
+		Where("status IN ?", []orderstatus.Status{orderstatus.Unpaid, orderstatus.AwaitingCash}).
+		Where("created_at < ?", createdBefore).
+		Find(order).
+		Error
+}
+
+func (r *CanteenDB) GetExpiredPointsList(entry *[]entity.LoyaltyEntry, earnedBefore time.Time) error {
+	return r.db.Debug().
+		Select("id").
+		Where("kind IN ?", []loyalty.Kind{loyalty.Earn, loyalty.Restore}).
+		Where("remaining > 0").
+		Where("created_at < ?", earnedBefore).
+		Find(entry).
+		Error
+}
+
+func (r *CanteenDB) GetPaymentNotification(notification *entity.PaymentNotification) error {
+	return r.db.Debug().
+		Where(&entity.PaymentNotification{
+			ID:                notification.ID,
+			TransactionID:     notification.TransactionID,
+			TransactionStatus: notification.TransactionStatus,
+		}).
+		First(notification).
+		Error
+}
+
+func (r *CanteenDB) GetPaymentNotificationList(notification *[]entity.PaymentNotification) error {
+	return r.db.Debug().
+		Order("created_at DESC").
+		Find(notification).
+		Error
+}
+
+func (r *CanteenDB) GetRefundList(refund *[]entity.Refund, userID uuid.UUID) error {
+	return r.db.Debug().
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(refund).
+		Error
+}
+
+func (r *CanteenDB) GetPendingRefundList(refund *[]entity.Refund, createdBefore time.Time) error {
+	return r.db.Debug().
+		Where("status = ?", refundstatus.Pending).
+		Where("created_at < ?", createdBefore).
+		Order("created_at").
+		Find(refund).
+		Error
+}
+
+func (r *CanteenDB) GetPendingPaymentList(payment *[]entity.Payment) error {
+	return r.db.Debug().
+		Where("status = ?", paymentstatus.Pending).
+		Where("method <> ?", paymentmethod.Cash).
+		Find(payment).
+		Error
+}
+
+func (r *CanteenDB) GetPayout(payout *entity.Payout, userID uuid.UUID) error {
+	query := r.db.Debug().
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("payment_date")
+		}).
+		Preload("Adjustments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("refund_date")
+		}).
+		Where("id = ?", payout.ID)
+
+	if userID != uuid.Nil {
+		sub := r.db.Debug().
+			Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)
+
+		query = query.Where("canteen_id IN (?)", sub)
+	}
+
+	return query.First(payout).Error
+}
+
+func (r *CanteenDB) GetPayoutList(payout *[]entity.Payout, userID uuid.UUID) error {
+	query := r.db.Debug().
+		Order("period_end DESC")
+
+	if userID != uuid.Nil {
+		sub := r.db.Debug().
+			Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)
+
+		query = query.Where("canteen_id IN (?)", sub)
+	}
+
+	return query.Find(payout).Error
+}
+
+func (r *CanteenDB) GetVoucherList(voucher *[]entity.Voucher, userID uuid.UUID) error {
+	query := r.db.Debug().
+		Order("created_at DESC")
+
+	if userID != uuid.Nil {
+		sub := r.db.Debug().
+			Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)
+
+		query = query.Where("canteen_id IN (?)", sub)
+	}
+
+	return query.Find(voucher).Error
+}
+
+func (r *CanteenDB) GetReconciliationReport(report *entity.ReconciliationReport) error {
+	return r.db.Debug().
+		Preload("Entries").
+		Where("id = ?", report.ID).
+		First(report).
+		Error
+}
+
+func (r *CanteenDB) GetReconciliationReportList(report *[]entity.ReconciliationReport) error {
+	return r.db.Debug().
+		Order("created_at DESC").
+		Find(report).
+		Error
+}
+
+func (r *CanteenDB) GetFeedback(feedback *entity.Feedback) error {
+	return r.db.Debug().
+		Select("id, order_id, user_id, content, created_at, updated_at").
+		Where("id = ?", feedback.ID).
+		First(&feedback).
+		Error
+}
+
+func (r *CanteenDB) SoftDeleteMenu(menu *entity.Menu, userID uuid.UUID) error {
+	sub := r.db.Debug().
+		Model(&entity.Canteen{}).
+		Select("id").
+		Where("user_id = ?", userID)
+
+	res := r.db.Debug().
+		Where("id = ?", menu.ID).
+		Where("canteen_id IN (?)", sub).
+		Delete(menu)
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return r.db.Debug().
+		Unscoped().
+		Select("canteen_id").
+		Where("id = ?", menu.ID).
+		First(menu).
+		Error
+}
+
+func (r *CanteenDB) SoftDeleteMenuCategory(category *entity.MenuCategory, userID uuid.UUID) error {
+	return r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		sub := tx.Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)
+
+		err := tx.Where("id = ?", category.ID).
+			Where("canteen_id IN (?)", sub).
+			First(category).
+			Error
+		if err != nil {
+			return err
+		}
+
+		res := tx.Where("id = ?", category.ID).
+			Delete(category)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Unscoped().
+			Model(&entity.Menu{}).
+			Where("category_id = ?", category.ID).
+			Update("category_id", uuid.Nil).
+			Error
+	})
+}
+
+func (r *CanteenDB) SoftDeleteFeedback(feedback *entity.Feedback, userID uuid.UUID) error {
+	canteenSub := r.db.Debug().
+		Model(&entity.Canteen{}).
+		Select("id").
+		Where("user_id = ?", userID)
+
+	orderSub := r.db.Debug().
+		Model(&entity.Order{}).
+		Select("id").
+		Where("status = ?", orderstatus.FeedbackSent).
+		Where("canteen_id IN (?)", canteenSub)
+
+	res := r.db.Debug().
+		Where("id = ?", feedback.ID).
+		Where("order_id IN (?)", orderSub).
+		Delete(feedback)
+
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return res.Error
+}
+
+func (r *CanteenDB) SoftDeleteVoucher(voucher *entity.Voucher, userID uuid.UUID) error {
+	query := r.db.Debug().
+		Where("id = ?", voucher.ID)
+
+	if userID != uuid.Nil {
+		sub := r.db.Debug().
+			Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)
+
+		query = query.Where("canteen_id IN (?)", sub)
+	}
+
+	res := query.Delete(voucher)
+
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return res.Error
+}
+
+func (r *CanteenDB) SoftDeletePromotion(promotion *entity.Promotion, menu *entity.Menu, userID uuid.UUID) error {
+	sub := r.db.Debug().
+		Model(&entity.Promotion{}).
+		Select("menu_id").
+		Where("id = ?", promotion.ID)
+
+	err := ownerMenu(r.db.Debug(), menu, sub, userID)
+	if err != nil {
+		return err
+	}
+
+	res := r.db.Debug().
+		Where("id = ?", promotion.ID).
+		Where("menu_id = ?", menu.ID).
+		Delete(promotion)
+
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return res.Error
+}
+
+func (r *CanteenDB) SoftDeleteMenuAvailability(availability *entity.MenuAvailability, menu *entity.Menu, userID uuid.UUID) error {
+	sub := r.db.Debug().
+		Model(&entity.MenuAvailability{}).
+		Select("menu_id").
+		Where("id = ?", availability.ID)
+
+	err := ownerMenu(r.db.Debug(), menu, sub, userID)
+	if err != nil {
+		return err
+	}
+
+	res := r.db.Debug().
+		Where("id = ?", availability.ID).
+		Where("menu_id = ?", menu.ID).
+		Delete(availability)
+
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return res.Error
+}
+
+func (r *CanteenDB) SoftDeleteModifierGroup(group *entity.ModifierGroup, menu *entity.Menu, userID uuid.UUID) error {
+	sub := r.db.Debug().
+		Model(&entity.ModifierGroup{}).
+		Select("menu_id").
+		Where("id = ?", group.ID)
+
+	err := ownerMenu(r.db.Debug(), menu, sub, userID)
+	if err != nil {
+		return err
+	}
+
+	res := r.db.Debug().
+		Where("id = ?", group.ID).
+		Where("menu_id = ?", menu.ID).
+		Delete(group)
+
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return res.Error
+}
+
+func ownerMenu(db *gorm.DB, menu *entity.Menu, menuID any, userID uuid.UUID) error {
+	return db.Where("id IN (?)", menuID).
+		Where("canteen_id IN (?)", db.Model(&entity.Canteen{}).
+			Select("id").
+			Where("user_id = ?", userID)).
+		First(menu).
+		Error
+}
+
+func ownedModifierGroups(db *gorm.DB, userID uuid.UUID) *gorm.DB {
+	return db.Model(&entity.ModifierGroup{}).
+		Select("id").
+		Where("menu_id IN (?)", db.Model(&entity.Menu{}).
+			Select("id").
+			Where("canteen_id IN (?)", db.Model(&entity.Canteen{}).
+				Select("id").
+				Where("user_id = ?", userID)))
+}
+
+func takeStock(tx *gorm.DB, menu *entity.Menu, item *entity.OrderItem) error {
+	stock := []entity.BundleItem{
+		{
+			MenuID:   menu.ID,
+			Quantity: 1,
+		},
+	}
+
+	if menu.Type == menutype.Bundle {
+		stock = slices.SortedFunc(slices.Values(menu.Components), func(a entity.BundleItem, b entity.BundleItem) int {
+			return strings.Compare(a.MenuID.String(), b.MenuID.String())
+		})
+	}
+
+	for _, component := range stock {
+		quantity := component.Quantity * item.Quantity
+
+		res := tx.Model(&entity.Menu{}).
+			Where("id = ?", component.MenuID).
+			Where("stock >= ?", quantity).
+			Update("stock", gorm.Expr("stock - ?", quantity))
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return ErrInsufficientStock
+		}
+
+		item.Components = append(item.Components, entity.OrderItemComponent{
+			ID:          uuid.New(),
+			OrderItemID: item.ID,
+			MenuID:      component.MenuID,
+			Quantity:    quantity,
+		})
+	}
+
+	return nil
+}
+
+func selectOptions(tx *gorm.DB, menu *entity.Menu, item *entity.OrderItem, now time.Time) error {
+	optionIDs := make([]uuid.UUID, len(item.Options))
+
+	for i, option := range item.Options {
+		optionIDs[i] = option.OptionID
+	}
+
+	options, ok := menu.SelectOptions(optionIDs)
+	if !ok {
+		return ErrInvalidOptions
+	}
+
+	tracked := make(map[uuid.UUID]bool)
+
+	for _, group := range menu.Modifiers {
+		for _, option := range group.Options {
+			tracked[option.ID] = option.Stock != nil
+		}
+	}
+
+	var delta int64
+
+	for i := range options {
+		options[i].ID = uuid.New()
+		options[i].OrderItemID = item.ID
+		delta += int64(options[i].PriceDelta)
+
+		if !tracked[options[i].OptionID] {
+			continue
+		}
+
+		res := tx.Model(&entity.ModifierOption{}).
+			Where("id = ?", options[i].OptionID).
+			Where("stock >= ?", item.Quantity).
+			Update("stock", gorm.Expr("stock - ?", item.Quantity))
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return ErrInsufficientStock
+		}
+	}
+
+	price, _ := menu.EffectivePrice(now)
+	if int64(price)+delta < 0 {
+		return ErrInvalidOptions
+	}
+
+	item.Name = menu.Name
+	item.OriginalPrice = uint32(max(int64(menu.Price)+delta, 0))
+	item.Price = uint32(int64(price) + delta)
+	item.Options = options
+
+	return nil
+}
+
+func activeAvailabilities(db *gorm.DB, now time.Time) *gorm.DB {
+	date := now.Format(time.DateOnly)
+	minute := now.Hour()*60 + now.Minute()
+
+	return db.Model(&entity.MenuAvailability{}).
+		Select("1").
+		Where("menu_availabilities.menu_id = menus.id").
+		Where("menu_availabilities.weekdays = 0 OR menu_availabilities.weekdays & ? <> 0", 1<<uint(now.Weekday())).
+		Where("menu_availabilities.start_date = '' OR menu_availabilities.start_date <= ?", date).
+		Where("menu_availabilities.end_date = '' OR menu_availabilities.end_date >= ?", date).
+		Where(
+			"menu_availabilities.start_minute = menu_availabilities.end_minute OR "+
+				"(menu_availabilities.start_minute < menu_availabilities.end_minute AND ? >= menu_availabilities.start_minute AND ? < menu_availabilities.end_minute) OR "+
+				"(menu_availabilities.start_minute > menu_availabilities.end_minute AND (? >= menu_availabilities.start_minute OR ? < menu_availabilities.end_minute))",
+			minute, minute, minute, minute,
+		)
+}
+
+func currentPromotions(now time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("valid_until IS NULL OR valid_until > ?", now)
+	}
+}
+
+func transitionOrder(tx *gorm.DB, order *entity.Order, status orderstatus.Status, changedBy uuid.UUID) error {
+	err := order.Status.Transition(status)
+	if err != nil {
+		return err
+	}
+
+	res := tx.Model(&entity.Order{}).
+		Where("id = ?", order.ID).
+		Where("status = ?", order.Status).
+		Update("status", status)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return &orderstatus.TransitionError{
+			From: order.Status,
+			To:   status,
+		}
+	}
+
+	history := entity.OrderStatusHistory{
+		ID:         uuid.New(),
+		OrderID:    order.ID,
+		FromStatus: order.Status,
+		ToStatus:   status,
+		ChangedBy:  changedBy,
+	}
+
+	order.Status = status
+
+	err = tx.Create(&history).Error
+	if err != nil {
+		return err
+	}
+
+	if status != orderstatus.Paid {
+		return nil
+	}
+
+	return issueReceipt(tx, order)
+}
+
+func issueReceipt(tx *gorm.DB, order *entity.Order) error {
+	canteen := entity.Canteen{
+		ID: order.CanteenID,
+	}
+
+	err := tx.Unscoped().
+		Model(&canteen).
+		Update("receipt_sequence", gorm.Expr("receipt_sequence + 1")).
+		Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Unscoped().
+		Select("id, receipt_sequence").
+		First(&canteen).
+		Error
+	if err != nil {
+		return err
+	}
+
+	paidAt := time.Now()
+
+	order.ReceiptNumber = canteen.ReceiptNumber(canteen.ReceiptSequence)
+	order.PaidAt = &paidAt
+
+	return tx.Model(&entity.Order{}).
+		Where("id = ?", order.ID).
+		Updates(map[string]any{
+			"receipt_number": order.ReceiptNumber,
+			"paid_at":        order.PaidAt,
+		}).
+		Error
+}
+
+func restoreStock(tx *gorm.DB, order *entity.Order) error {
+	for _, item := range order.OrderItems {
+		var components []entity.OrderItemComponent
+
+		err := tx.Where("order_item_id = ?", item.ID).
+			Find(&components).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if len(components) == 0 {
+			components = append(components, entity.OrderItemComponent{
+				MenuID:   item.MenuID,
+				Quantity: item.Quantity,
+			})
+		}
+
+		for _, component := range components {
+			err = tx.Unscoped().
+				Model(&entity.Menu{}).
+				Where("id = ?", component.MenuID).
+				Update("stock", gorm.Expr("stock + ?", component.Quantity)).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Unscoped().
+			Model(&entity.ModifierOption{}).
+			Where("id IN (?)", tx.Model(&entity.OrderItemOption{}).
+				Select("option_id").
+				Where("order_item_id = ?", item.ID)).
+			Where("stock IS NOT NULL").
+			Update("stock", gorm.Expr("stock + ?", item.Quantity)).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func closeCashPayment(tx *gorm.DB, order *entity.Order, status paymentstatus.Status) error {
+	return tx.Model(&entity.Payment{}).
+		Where("order_id = ?", order.ID).
+		Where("method = ?", paymentmethod.Cash).
+		Where("status = ?", paymentstatus.Pending).
+		Update("status", status).
+		Error
+}
+
+func redeemVoucher(tx *gorm.DB, order *entity.Order) error {
+	var voucher entity.Voucher
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ?", order.VoucherCode).
+		First(&voucher).
+		Error
+	if err == gorm.ErrRecordNotFound {
+		return ErrInvalidVoucher
+	} else if err != nil {
+		return err
+	}
+
+	if !voucher.Applicable(order, time.Now()) {
+		return ErrInvalidVoucher
+	}
+
+	if voucher.UsageLimit != 0 && voucher.UsedCount >= voucher.UsageLimit {
+		return ErrVoucherLimitReached
+	}
+
+	if voucher.PerUserLimit != 0 {
+		var count int64
+
+		err = tx.Model(&entity.VoucherRedemption{}).
+			Where("voucher_id = ?", voucher.ID).
+			Where("user_id = ?", order.UserID).
+			Count(&count).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if count >= int64(voucher.PerUserLimit) {
+			return ErrVoucherLimitReached
+		}
+	}
+
+	order.Discount = min(voucher.CalculateDiscount(order), order.CalculateSubtotal()-order.PointsUsed)
+
+	if order.Discount == 0 {
+		return ErrInvalidVoucher
+	}
+
+	err = tx.Model(&voucher).
+		Update("used_count", gorm.Expr("used_count + 1")).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&entity.VoucherRedemption{
+		ID:        uuid.New(),
+		VoucherID: voucher.ID,
+		UserID:    order.UserID,
+		OrderID:   order.ID,
+		Discount:  order.Discount,
+	}).Error
+}
+
+func releaseVoucher(tx *gorm.DB, order *entity.Order) error {
+	var redemption entity.VoucherRedemption
+
+	res := tx.Where("order_id = ?", order.ID).
+		Limit(1).
+		Find(&redemption)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return nil
+	}
+
+	err := tx.Delete(&redemption).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Unscoped().
+		Model(&entity.Voucher{}).
+		Where("id = ?", redemption.VoucherID).
+		Update("used_count", gorm.Expr("used_count - 1")).
+		Error
+}
+
+func earnPoints(tx *gorm.DB, order *entity.Order, rate uint32) error {
+	var canteen entity.Canteen
+
+	err := tx.Select("id, loyalty_rate").
+		Where("id = ?", order.CanteenID).
+		First(&canteen).
+		Error
+	if err != nil {
+		return err
+	}
+
+	if canteen.LoyaltyRate != 0 {
+		rate = canteen.LoyaltyRate
+	}
+
+	points := uint32(uint64(order.Total) * uint64(rate) / 10000)
+
+	if points == 0 {
+		return nil
+	}
+
+	return creditPoints(tx, order.UserID, order.ID, loyalty.Earn, points)
+}
+
+func restorePoints(tx *gorm.DB, order *entity.Order) error {
+	var count int64
+
+	if order.PointsUsed == 0 {
+		return nil
+	}
+
+	err := tx.Model(&entity.LoyaltyEntry{}).
+		Where("order_id = ?", order.ID).
+		Where("kind = ?", loyalty.Restore).
+		Count(&count).
+		Error
+	if err != nil || count != 0 {
+		return err
+	}
+
+	return creditPoints(tx, order.UserID, order.ID, loyalty.Restore, order.PointsUsed)
+}
+
+func creditPoints(tx *gorm.DB, userID uuid.UUID, orderID uuid.UUID, kind loyalty.Kind, points uint32) error {
+	account := entity.LoyaltyAccount{
+		ID:     uuid.New(),
+		UserID: userID,
+	}
+
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&account).
+		Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Model(&entity.LoyaltyAccount{}).
+		Where("user_id = ?", userID).
+		Update("balance", gorm.Expr("balance + ?", points)).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&entity.LoyaltyEntry{
+		ID:        uuid.New(),
+		UserID:    userID,
+		OrderID:   orderID,
+		Kind:      kind,
+		Points:    int32(points),
+		Remaining: points,
+	}).Error
+}
+
+func debitPoints(tx *gorm.DB, userID uuid.UUID, orderID uuid.UUID, points uint32) error {
+	var credits []entity.LoyaltyEntry
+
+	res := tx.Model(&entity.LoyaltyAccount{}).
+		Where("user_id = ?", userID).
+		Where("balance >= ?", points).
+		Update("balance", gorm.Expr("balance - ?", points))
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrInsufficientPoints
+	}
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", userID).
+		Where("remaining > 0").
+		Order("created_at").
+		Find(&credits).
+		Error
+	if err != nil {
+		return err
+	}
+
+	left := points
+
+	for _, credit := range credits {
+		if left == 0 {
+			break
+		}
+
+		spent := min(credit.Remaining, left)
+		left -= spent
+
+		err = tx.Model(&entity.LoyaltyEntry{}).
+			Where("id = ?", credit.ID).
+			Update("remaining", gorm.Expr("remaining - ?", spent)).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Create(&entity.LoyaltyEntry{
+		ID:      uuid.New(),
+		UserID:  userID,
+		OrderID: orderID,
+		Kind:    loyalty.Redeem,
+		Points:  -int32(points),
+	}).Error
+}
+
+func creditWallet(tx *gorm.DB, userID uuid.UUID, amount uint32) error {
+	wallet := entity.Wallet{
+		ID:     uuid.New(),
+		UserID: userID,
+	}
+
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&wallet).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Model(&entity.Wallet{}).
+		Where("user_id = ?", userID).
+		Update("balance", gorm.Expr("balance + ?", amount)).
+		Error
+}
+
+func debitWallet(tx *gorm.DB, userID uuid.UUID, amount uint32) error {
+	res := tx.Model(&entity.Wallet{}).
+		Where("user_id = ?", userID).
+		Where("balance >= ?", amount).
+		Update("balance", gorm.Expr("balance - ?", amount))
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrInsufficientBalance
+	}
+
+	return nil
+}
+
+func reserveRefund(tx *gorm.DB, refund *entity.Refund, order *entity.Order, payment *entity.Payment) error {
+	var pending int64
+
+	err := tx.Model(&entity.Refund{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("payment_id = ?", payment.ID).
+		Where("status = ?", refundstatus.Pending).
+		Scan(&pending).
+		Error
+	if err != nil {
+		return err
+	}
+
+	remaining := payment.Price - payment.RefundedAmount - uint32(pending)
+
+	if refund.Amount == 0 {
+		refund.Amount = remaining
+	}
+
+	if refund.Amount == 0 || refund.Amount > remaining {
+		return ErrInvalidRefundAmount
+	}
+
+	status := orderstatus.PartiallyRefunded
+
+	if refund.Amount == remaining {
+		status = orderstatus.Refunded
+	}
+
+	if order != nil {
+		err = order.Status.Transition(status)
+		if err != nil {
+			return err
+		}
+	}
+
+	refund.PaymentID = payment.ID
+	refund.UserID = payment.UserID
+	refund.Status = refundstatus.Pending
+
+	return tx.Create(refund).Error
+}
+
+func refundToWallet(tx *gorm.DB, payment *entity.Payment, canteenID uuid.UUID, amount uint32) error {
+	err := creditWallet(tx, payment.UserID, amount)
+	if err != nil {
+		return err
+	}
+
+	return postLedger(
+		tx,
+		payment.ID,
+		ledger.CanteenAccount(canteenID),
+		ledger.WalletAccount(payment.UserID),
+		amount,
+		"order refund",
+	)
+}
+
+func postLedger(tx *gorm.DB, paymentID uuid.UUID, debit string, credit string, amount uint32, description string) error {
+	transactionID := uuid.New()
+
+	entries := []entity.LedgerEntry{
+		{
+			ID:            uuid.New(),
+			TransactionID: transactionID,
+			Account:       debit,
+			Direction:     ledger.Debit,
+			Amount:        amount,
+			PaymentID:     paymentID,
+			Description:   description,
+		},
+		{
+			ID:            uuid.New(),
+			TransactionID: transactionID,
+			Account:       credit,
+			Direction:     ledger.Credit,
+			Amount:        amount,
+			PaymentID:     paymentID,
+			Description:   description,
+		},
+	}
+
+	return tx.Create(&entries).Error
 }
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/entity"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/menutype"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/orderstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/db"
+	"github.com/google/uuid"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestCreateOrderConcurrentStock(t *testing.T) {
+	dsn := os.Getenv("TEST_DB_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DB_DSN is not set")
+	}
+
+	database, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db.Migrate(database)
+
+	const stock = 10
+	const workers = 50
+
+	canteen := entity.Canteen{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Name:   "concurrency test",
+	}
+
+	menu := entity.Menu{
+		ID:        uuid.New(),
+		CanteenID: canteen.ID,
+		Name:      "concurrency test",
+		Type:      menutype.Single,
+		Price:     1000,
+		Stock:     stock,
+	}
+
+	err = database.Create(&canteen).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = database.Create(&menu).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		orderSub := database.Model(&entity.Order{}).
+			Select("id").
+			Where("canteen_id = ?", canteen.ID)
+
+		itemSub := database.Model(&entity.OrderItem{}).
+			Select("id").
+			Where("order_id IN (?)", orderSub)
+
+		database.Unscoped().Where("order_item_id IN (?)", itemSub).Delete(&entity.OrderItemComponent{})
+		database.Unscoped().Where("order_id IN (?)", orderSub).Delete(&entity.OrderItem{})
+		database.Unscoped().Where("canteen_id = ?", canteen.ID).Delete(&entity.Order{})
+		database.Unscoped().Delete(&menu)
+		database.Unscoped().Delete(&canteen)
+	})
+
+	repo := NewCanteenDB(database)
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	succeeded := 0
+	errs := make([]error, 0, workers)
+
+	for range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			orderID := uuid.New()
+
+			order := entity.Order{
+				ID:        orderID,
+				CanteenID: canteen.ID,
+				UserID:    uuid.New(),
+				Status:    orderstatus.Unpaid,
+				OrderItems: []entity.OrderItem{
+					{
+						ID:       uuid.New(),
+						OrderID:  orderID,
+						MenuID:   menu.ID,
+						Quantity: 1,
+					},
+				},
+			}
+
+			err := repo.CreateOrder(&order, time.Now(), func(order *entity.Order) {})
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err == nil {
+				succeeded++
+			} else {
+				errs = append(errs, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if !errors.Is(err, ErrInsufficientStock) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	if succeeded != stock {
+		t.Errorf("expected %d successful orders, got %d", stock, succeeded)
+	}
+
+	var remaining entity.Menu
+
+	err = database.Where("id = ?", menu.ID).First(&remaining).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if remaining.Stock != 0 {
+		t.Errorf("expected stock to reach 0, got %d", remaining.Stock)
+	}
+
+	var orders int64
+
+	err = database.Model(&entity.Order{}).
+		Where("canteen_id = ?", canteen.ID).
+		Count(&orders).
+		Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if orders != stock {
+		t.Errorf("expected %d stored orders, got %d", stock, orders)
+	}
+}
@@ -3,43 +3,104 @@ package usecase
 
 import (
 	"context"
-	"crypto/sha512"
-	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"log"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/SyafaHadyan/freepass-2026/internal/app/canteen/repository"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/discount"
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/entity"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/menutype"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/orderstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentmethod"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/reconciliation"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/settlement"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/userrole"
 	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/imaging"
 	"github.com/SyafaHadyan/freepass-2026/internal/infra/payment"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/pdf"
 	redisitf "github.com/SyafaHadyan/freepass-2026/internal/infra/redis"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/storage"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+const lateSettlementReason = "payment settled after the order was closed"
+
 type CanteenUseCaseItf interface {
 	CreateCanteen(createCanteen dto.CreateCanteen) (dto.ResponseCreateCanteen, error)
 	CreateMenu(createMenu dto.CreateMenu, userID uuid.UUID) (dto.ResponseCreateMenu, error)
+	CreateMenuCategory(createMenuCategory dto.CreateMenuCategory, userID uuid.UUID) (dto.ResponseMenuCategory, error)
 	CreateOrder(createOrder dto.CreateOrder) (dto.ResponseCreateOrder, error)
 	CreatePayment(createPayment dto.CreatePayment) (dto.ResponseMidtransOrder, error)
 	VerifyPayment(verifyPayment dto.VerifyPayment) error
+	ConfirmCashPayment(orderID uuid.UUID, userID uuid.UUID) error
+	ReplayPaymentNotification(notificationID uuid.UUID) error
+	RefundOrder(createRefund dto.CreateRefund, userID uuid.UUID, role string) (dto.ResponseGetRefund, error)
 	CreateFeedback(createFeedback dto.CreateFeedback) (dto.ResponseCreateFeedback, error)
+	CreateVoucher(createVoucher dto.CreateVoucher, userID uuid.UUID, role string) (dto.ResponseGetVoucher, error)
+	CreatePromotion(createPromotion dto.CreatePromotion, userID uuid.UUID) (dto.ResponsePromotion, error)
+	CreateModifierGroup(createModifierGroup dto.CreateModifierGroup, userID uuid.UUID) (dto.ResponseModifierGroup, error)
+	CreateMenuAvailability(createMenuAvailability dto.CreateMenuAvailability, userID uuid.UUID) (dto.ResponseMenuAvailability, error)
+	UpdateCanteen(updateCanteen dto.UpdateCanteen) (dto.ResponseUpdateCanteen, error)
 	UpdateMenu(updateMenu dto.UpdateMenu) (dto.ResponseUpdateMenu, error)
+	UpdateMenuCategory(updateMenuCategory dto.UpdateMenuCategory) (dto.ResponseMenuCategory, error)
+	UpdateModifierOption(updateModifierOption dto.UpdateModifierOption) (dto.ResponseModifierOption, error)
+	RestockMenu(restockMenu dto.RestockMenu) ([]dto.ResponseUpdateMenu, error)
 	UpdateOrder(updateOrder dto.UpdateOrder, userID uuid.UUID) (dto.ResponseUpdateOrder, error)
+	CancelOrder(cancelOrder dto.CancelOrder) error
+	RejectOrder(rejectOrder dto.RejectOrder, userID uuid.UUID) (dto.ResponseUpdateOrder, error)
+	ExpireOrders() error
+	RetryRefunds() error
+	ExpirePoints() error
+	ResetStock() error
+	ReconcilePayments() error
+	GeneratePayouts(period settlement.Period) ([]dto.ResponseGetPayout, error)
+	SettlePayouts() error
+	MarkPayoutPaid(payoutID uuid.UUID, userID uuid.UUID) (dto.ResponseGetPayout, error)
 	GetCanteenList() ([]dto.ResponseGetCanteenList, error)
 	GetCanteenInfo(canteenID uuid.UUID) (dto.ResponseGetCanteenInfo, error)
 	GetMenuInfo(menuID uuid.UUID) (dto.ResponseGetMenuInfo, error)
+	GetMenuCategoryList(canteenID uuid.UUID) ([]dto.ResponseMenuCategory, error)
+	GetCanteenMenu(canteenID uuid.UUID) (dto.ResponseGetCanteenMenu, error)
+	GetMenuList(getMenuList dto.GetMenuList) (dto.ResponseGetMenuList, error)
+	GetPromotionList(menuID uuid.UUID) ([]dto.ResponsePromotion, error)
+	GetModifierGroupList(menuID uuid.UUID) ([]dto.ResponseModifierGroup, error)
+	GetMenuAvailabilityList(menuID uuid.UUID) ([]dto.ResponseMenuAvailability, error)
 	GetOrderInfo(getOrderInfo dto.GetOrderInfo) (dto.ResponseGetOrderInfo, error)
 	GetOrderList(userID uuid.UUID) ([]dto.ResponseGetOrderList, error)
+	GetReceipt(getOrderInfo dto.GetOrderInfo) (dto.ResponseGetReceipt, error)
+	GetReceiptPDF(getOrderInfo dto.GetOrderInfo) ([]byte, error)
+	GetPaymentNotificationList() ([]dto.ResponseGetPaymentNotification, error)
+	GetRefundList(userID uuid.UUID) ([]dto.ResponseGetRefund, error)
+	GetPayout(payoutID uuid.UUID, userID uuid.UUID, role string) (dto.ResponseGetPayout, error)
+	GetPayoutList(userID uuid.UUID, role string) ([]dto.ResponseGetPayout, error)
+	GetVoucherList(userID uuid.UUID, role string) ([]dto.ResponseGetVoucher, error)
+	GetReconciliationReport(reportID uuid.UUID) (dto.ResponseGetReconciliationReport, error)
+	GetReconciliationReportList() ([]dto.ResponseGetReconciliationReport, error)
 	GetFeedback(feedbackID uuid.UUID) (dto.ResponseGetFeedback, error)
 	SoftDeleteMenu(menuID uuid.UUID, userID uuid.UUID) error
+	SoftDeleteMenuCategory(categoryID uuid.UUID, userID uuid.UUID) error
 	SoftDeleteFeedback(feedbackID uuid.UUID, userID uuid.UUID) error
+	SoftDeleteVoucher(voucherID uuid.UUID, userID uuid.UUID, role string) error
+	SoftDeletePromotion(promotionID uuid.UUID, userID uuid.UUID) error
+	SoftDeleteModifierGroup(groupID uuid.UUID, userID uuid.UUID) error
+	SoftDeleteMenuAvailability(availabilityID uuid.UUID, userID uuid.UUID) error
 }
 
 type CanteenUseCase struct {
 	canteenRepo  repository.CanteenDBItf
 	Payment      payment.PaymentItf
+	Storage      storage.StorageItf
 	Env          *env.Env
 	redis        redisitf.RedisItf
 	redisContext context.Context
@@ -47,11 +108,12 @@ type CanteenUseCase struct {
 
 func NewCanteenUseCase(
 	canteenRepo repository.CanteenDBItf, payment payment.PaymentItf,
-	env *env.Env, redis redisitf.RedisItf,
+	storage storage.StorageItf, env *env.Env, redis redisitf.RedisItf,
 ) CanteenUseCaseItf {
 	return &CanteenUseCase{
 		canteenRepo:  canteenRepo,
 		Payment:      payment,
+		Storage:      storage,
 		Env:          env,
 		redis:        redis,
 		redisContext: context.Background(),
@@ -60,9 +122,16 @@ func NewCanteenUseCase(
 
 func (c *CanteenUseCase) CreateCanteen(createCanteen dto.CreateCanteen) (dto.ResponseCreateCanteen, error) {
 	canteen := entity.Canteen{
-		ID:     uuid.New(),
-		UserID: createCanteen.UserID,
-		Name:   createCanteen.Name,
+		ID:               uuid.New(),
+		UserID:           createCanteen.UserID,
+		Name:             createCanteen.Name,
+		AllowCashPayment: createCanteen.AllowCashPayment,
+		ServiceFeeRate:   createCanteen.ServiceFeeRate,
+		TaxRate:          createCanteen.TaxRate,
+		RoundingUnit:     createCanteen.RoundingUnit,
+		LoyaltyRate:      createCanteen.LoyaltyRate,
+		Timezone:         createCanteen.Timezone,
+		StockResetTime:   createCanteen.StockResetTime,
 	}
 
 	err := c.canteenRepo.CreateCanteen(&canteen)
@@ -72,117 +141,377 @@ func (c *CanteenUseCase) CreateCanteen(createCanteen dto.CreateCanteen) (dto.Res
 
 func (c *CanteenUseCase) CreateMenu(createMenu dto.CreateMenu, userID uuid.UUID) (dto.ResponseCreateMenu, error) {
 	menu := entity.Menu{
-		ID:        uuid.New(),
-		CanteenID: createMenu.CanteenID,
-		Name:      createMenu.Name,
-		Price:     createMenu.Price,
-		Stock:     createMenu.Stock,
+		ID:         uuid.New(),
+		CanteenID:  createMenu.CanteenID,
+		CategoryID: createMenu.CategoryID,
+		Name:       createMenu.Name,
+		Type:       menutype.Single,
+		Position:   createMenu.Position,
+		Price:      createMenu.Price,
+		Stock:      createMenu.Stock,
+		ParLevel:   createMenu.ParLevel,
+	}
+
+	if createMenu.Type == string(menutype.Bundle) {
+		menu.Type = menutype.Bundle
+		menu.Stock = 0
+		menu.ParLevel = 0
+
+		for _, component := range createMenu.Components {
+			menu.Components = append(menu.Components, entity.BundleItem{
+				ID:       uuid.New(),
+				BundleID: menu.ID,
+				MenuID:   component.MenuID,
+				Quantity: component.Quantity,
+			})
+		}
+	} else if len(createMenu.Components) != 0 {
+		return dto.ResponseCreateMenu{}, fiber.NewError(http.StatusBadRequest, "only bundle menus have components")
+	}
+
+	if len(createMenu.Image) != 0 {
+		canteen := entity.Canteen{
+			ID: menu.CanteenID,
+		}
+
+		err := c.canteenRepo.GetOwnedCanteen(&canteen, userID)
+		if err != nil {
+			return dto.ResponseCreateMenu{}, err
+		}
+
+		variants, err := c.processMenuImage(&menu, createMenu.Image)
+		if err != nil {
+			return dto.ResponseCreateMenu{}, err
+		}
+
+		err = c.storeMenuImage(&menu, variants)
+		if err != nil {
+			return dto.ResponseCreateMenu{}, err
+		}
 	}
 
 	err := c.canteenRepo.CreateMenu(&menu, userID)
+	if err != nil && menu.Image != "" {
+		c.deleteMenuImage(&menu)
+	} else if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
 
 	return menu.ParseToDTOResponseCreateMenu(), err
 }
 
-func (c *CanteenUseCase) CreateOrder(createOrder dto.CreateOrder) (dto.ResponseCreateOrder, error) {
-	menu := entity.Menu{
-		ID: createOrder.MenuID,
+func (c *CanteenUseCase) CreateMenuCategory(createMenuCategory dto.CreateMenuCategory, userID uuid.UUID) (dto.ResponseMenuCategory, error) {
+	category := entity.MenuCategory{
+		ID:        uuid.New(),
+		CanteenID: createMenuCategory.CanteenID,
+		Name:      createMenuCategory.Name,
+		Position:  createMenuCategory.Position,
 	}
 
+	err := c.canteenRepo.CreateMenuCategory(&category, userID)
+
+	return category.ParseToDTOResponseMenuCategory(), err
+}
+
+func (c *CanteenUseCase) CreateOrder(createOrder dto.CreateOrder) (dto.ResponseCreateOrder, error) {
 	order := entity.Order{
-		ID:        uuid.New(),
-		UserID:    createOrder.UserID,
-		CanteenID: createOrder.CanteenID,
-		MenuID:    createOrder.MenuID,
-		Quantity:  createOrder.Quantity,
-		Status:    "UNPAID",
+		ID:          uuid.New(),
+		UserID:      createOrder.UserID,
+		CanteenID:   createOrder.CanteenID,
+		VoucherCode: strings.ToUpper(createOrder.VoucherCode),
+		Status:      orderstatus.Unpaid,
+		OrderItems:  make([]entity.OrderItem, len(createOrder.Items)),
 	}
 
-	err := c.canteenRepo.CreateOrder(&menu, &order)
+	for i, item := range createOrder.Items {
+		order.OrderItems[i] = entity.OrderItem{
+			ID:       uuid.New(),
+			OrderID:  order.ID,
+			MenuID:   item.MenuID,
+			Quantity: item.Quantity,
+			Options:  make([]entity.OrderItemOption, len(item.Options)),
+		}
+
+		for j, optionID := range item.Options {
+			order.OrderItems[i].Options[j] = entity.OrderItemOption{
+				OptionID: optionID,
+			}
+		}
+	}
+
+	canteen := entity.Canteen{
+		ID: createOrder.CanteenID,
+	}
+
+	err := c.canteenRepo.GetCanteenInfo(&canteen)
+	if err != nil {
+		return dto.ResponseCreateOrder{}, err
+	}
+
+	err = c.canteenRepo.CreateOrder(&order, time.Now().In(canteen.Location()), func(order *entity.Order) {
+		priceOrder(order, canteen)
+	})
+	if err == nil {
+		c.invalidateMenuList(order.CanteenID)
+	}
 
 	return order.ParseToDTOResponseCreateOrder(), err
 }
 
 func (c *CanteenUseCase) CreatePayment(createPayment dto.CreatePayment) (dto.ResponseMidtransOrder, error) {
-	orderInfo := entity.Order{
-		ID: createPayment.OrderID,
+	payment := entity.Payment{
+		ID:      uuid.New(),
+		OrderID: createPayment.OrderID,
+		UserID:  createPayment.UserID,
+		Price:   createPayment.Price,
+		Status:  paymentstatus.Pending,
+		Method:  paymentmethod.Gateway,
+		Purpose: createPayment.Purpose,
 	}
 
-	_ = c.canteenRepo.GetOrderInfo(&orderInfo)
+	if payment.Purpose != paymentmethod.Order && payment.Purpose != paymentmethod.TopUp {
+		return dto.ResponseMidtransOrder{}, fiber.NewError(
+			http.StatusBadRequest,
+			"invalid payment purpose",
+		)
+	}
 
-	menuInfo := entity.Menu{
-		ID: orderInfo.MenuID,
+	itemDetail := []dto.ItemDetail{
+		{
+			ID:       "wallet-topup",
+			Name:     "Wallet top-up",
+			Price:    int64(payment.Price),
+			Quantity: 1,
+		},
 	}
 
-	paymentID := uuid.New()
+	if payment.Purpose == paymentmethod.Order {
+		orderInfo := entity.Order{
+			ID:     createPayment.OrderID,
+			UserID: createPayment.UserID,
+		}
+
+		err := c.canteenRepo.GetOrderInfo(&orderInfo)
+		if err != nil {
+			return dto.ResponseMidtransOrder{}, err
+		}
+
+		err = orderInfo.Status.Transition(orderstatus.Paid)
+		if err != nil {
+			return dto.ResponseMidtransOrder{}, err
+		}
+
+		pendingPayments := new([]entity.Payment)
+
+		err = c.canteenRepo.GetPendingOrderPaymentList(pendingPayments, orderInfo.ID)
+		if err != nil {
+			return dto.ResponseMidtransOrder{}, err
+		}
+
+		if len(*pendingPayments) != 0 {
+			return dto.ResponseMidtransOrder{}, fiber.NewError(
+				http.StatusConflict,
+				"order already has a pending payment",
+			)
+		}
+
+		if createPayment.VoucherCode != "" || createPayment.UsePoints != 0 {
+			canteen := entity.Canteen{
+				ID: orderInfo.CanteenID,
+			}
+
+			err = c.canteenRepo.GetCanteenInfo(&canteen)
+			if err != nil {
+				return dto.ResponseMidtransOrder{}, err
+			}
+
+			price := func(order *entity.Order) {
+				priceOrder(order, canteen)
+			}
+
+			if createPayment.VoucherCode != "" {
+				orderInfo = entity.Order{
+					ID:          orderInfo.ID,
+					UserID:      orderInfo.UserID,
+					VoucherCode: strings.ToUpper(createPayment.VoucherCode),
+				}
+
+				err = c.canteenRepo.ApplyVoucher(&orderInfo, price)
+				if err != nil {
+					return dto.ResponseMidtransOrder{}, err
+				}
+			}
+
+			if createPayment.UsePoints != 0 {
+				orderInfo = entity.Order{
+					ID:         orderInfo.ID,
+					UserID:     orderInfo.UserID,
+					PointsUsed: createPayment.UsePoints,
+				}
+
+				err = c.canteenRepo.UsePoints(&orderInfo, price)
+				if err != nil {
+					return dto.ResponseMidtransOrder{}, err
+				}
+			}
+		}
+
+		payment.Price = orderInfo.Total
+		itemDetail = itemDetails(orderInfo)
+
+		switch createPayment.Method {
+		case paymentmethod.Wallet:
+			payment.Method = paymentmethod.Wallet
+
+			err = c.canteenRepo.PayWithWallet(&payment)
+
+			return dto.ResponseMidtransOrder{
+				Status: payment.Status,
+			}, err
+		case paymentmethod.Cash:
+			canteen := entity.Canteen{
+				ID: orderInfo.CanteenID,
+			}
+
+			err = c.canteenRepo.GetCanteenInfo(&canteen)
+			if err != nil {
+				return dto.ResponseMidtransOrder{}, err
+			}
+
+			if !canteen.AllowCashPayment {
+				return dto.ResponseMidtransOrder{}, fiber.NewError(
+					http.StatusUnprocessableEntity,
+					"canteen does not accept cash payment",
+				)
+			}
+
+			payment.Method = paymentmethod.Cash
+
+			err = c.canteenRepo.PayWithCash(&payment)
+
+			return dto.ResponseMidtransOrder{
+				Status: payment.Status,
+			}, err
+		}
+	}
 
 	createMidtransOrder := dto.CreateMidtransOrder{
 		TransactionDetails: dto.TransactionDetails{
-			OrderID:     paymentID.String(),
-			GrossAmount: orderInfo.Quantity * menuInfo.Price,
+			OrderID:     payment.ID.String(),
+			GrossAmount: payment.Price,
 		},
+		ItemDetails: itemDetail,
 	}
 
-	res, err := c.Payment.CreatePayment(createMidtransOrder)
+	responseMidtransOrder, err := c.Payment.CreatePayment(createMidtransOrder)
 	if err != nil {
 		return dto.ResponseMidtransOrder{}, err
 	}
 
-	responseMidtransOrder := dto.ResponseMidtransOrder{
-		Token:       res.Token,
-		RedirectURL: res.RedirectURL,
+	payment.RedirectURL = responseMidtransOrder.RedirectURL
+	responseMidtransOrder.Status = payment.Status
+
+	err = c.canteenRepo.CreatePayment(&payment)
+
+	return responseMidtransOrder, err
+}
+
+func (c *CanteenUseCase) VerifyPayment(verifyPayment dto.VerifyPayment) error {
+	err := c.Payment.VerifyNotification(verifyPayment)
+	if err != nil {
+		return err
+	}
+
+	notification := entity.PaymentNotification{
+		ID:                uuid.New(),
+		OrderID:           verifyPayment.OrderID,
+		TransactionID:     verifyPayment.TransactionID,
+		TransactionStatus: verifyPayment.TransactionStatus,
+		StatusCode:        verifyPayment.StatusCode,
+		GrossAmount:       verifyPayment.GrossAmount,
+		SignatureKey:      verifyPayment.SignatureKey,
+	}
+
+	err = c.canteenRepo.CreatePaymentNotification(&notification)
+	if err == repository.ErrDuplicateNotification {
+		notification.ID = uuid.Nil
+
+		err = c.canteenRepo.GetPaymentNotification(&notification)
+		if err != nil || notification.Processed {
+			return err
+		}
+	} else if err != nil {
+		return err
 	}
 
+	return c.processPaymentNotification(&notification)
+}
+
+func (c *CanteenUseCase) ConfirmCashPayment(orderID uuid.UUID, userID uuid.UUID) error {
 	payment := entity.Payment{
-		ID:          paymentID,
-		OrderID:     createPayment.OrderID,
-		UserID:      createPayment.UserID,
-		Price:       createPayment.Price,
-		RedirectURL: responseMidtransOrder.RedirectURL,
+		OrderID: orderID,
 	}
 
-	err = c.canteenRepo.CreatePayment(&payment)
+	err := c.canteenRepo.ConfirmCashPayment(&payment, userID)
 
-	return responseMidtransOrder, err
+	return err
 }
 
-func (c *CanteenUseCase) VerifyPayment(verifyPayment dto.VerifyPayment) error {
-	orderID, _ := uuid.Parse(verifyPayment.TransactionID)
-	transactionStatus := verifyPayment.TransactionStatus
-
-	signatureKey := fmt.Sprintf(
-		"%s%s%s%s",
-		verifyPayment.TransactionID,
-		verifyPayment.StatusCode,
-		verifyPayment.GrossAmount,
-		c.Env.MidtransServerKey,
-	)
+func (c *CanteenUseCase) ReplayPaymentNotification(notificationID uuid.UUID) error {
+	notification := entity.PaymentNotification{
+		ID: notificationID,
+	}
 
-	hash := sha512.New()
-	hash.Write([]byte(signatureKey))
-	hashedData := hash.Sum(nil)
-	hexHash := hex.EncodeToString(hashedData)
+	err := c.canteenRepo.GetPaymentNotification(&notification)
+	if err != nil {
+		return err
+	}
 
-	if hexHash != verifyPayment.SignatureKey {
-		return fiber.NewError(
-			http.StatusUnauthorized,
-			"payment could not be verified")
+	return c.processPaymentNotification(&notification)
+}
+
+func (c *CanteenUseCase) RefundOrder(createRefund dto.CreateRefund, userID uuid.UUID, role string) (dto.ResponseGetRefund, error) {
+	refund := entity.Refund{
+		ID:          uuid.New(),
+		OrderID:     createRefund.OrderID,
+		Amount:      createRefund.Amount,
+		Reason:      createRefund.Reason,
+		RequestedBy: userID,
 	}
 
-	if transactionStatus == "capture" || transactionStatus == "settlement" {
-	} else {
-		return fiber.NewError(
-			http.StatusUnauthorized,
-			"payment could not be verified")
+	ownerID := userID
+	if role == string(userrole.Admin) {
+		ownerID = uuid.Nil
 	}
 
-	order := entity.Order{
-		ID: orderID,
+	err := c.canteenRepo.ReserveRefund(&refund, ownerID)
+	if err != nil {
+		return dto.ResponseGetRefund{}, err
 	}
 
-	err := c.canteenRepo.VerifyPayment(&order)
+	err = c.completeRefund(&refund)
 
-	return err
+	return refund.ParseToDTOResponseGetRefund(), err
+}
+
+func (c *CanteenUseCase) RetryRefunds() error {
+	refunds := new([]entity.Refund)
+
+	createdBefore := time.Now().Add(-time.Duration(c.Env.RefundRetryIntervalMinutes) * time.Minute)
+
+	err := c.canteenRepo.GetPendingRefundList(refunds, createdBefore)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range *refunds {
+		err := c.completeRefund(&r)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	return nil
 }
 
 func (c *CanteenUseCase) CreateFeedback(createFeedback dto.CreateFeedback) (dto.ResponseCreateFeedback, error) {
@@ -198,17 +527,316 @@ func (c *CanteenUseCase) CreateFeedback(createFeedback dto.CreateFeedback) (dto.
 	return feedback.ParseToDTOResponseCreateFeedback(), err
 }
 
+func (c *CanteenUseCase) CreateVoucher(createVoucher dto.CreateVoucher, userID uuid.UUID, role string) (dto.ResponseGetVoucher, error) {
+	voucher := entity.Voucher{
+		ID:           uuid.New(),
+		Code:         strings.ToUpper(createVoucher.Code),
+		CreatedBy:    userID,
+		CanteenID:    createVoucher.CanteenID,
+		MenuID:       createVoucher.MenuID,
+		Type:         discount.Type(createVoucher.Type),
+		Value:        createVoucher.Value,
+		MinSpend:     createVoucher.MinSpend,
+		MaxDiscount:  createVoucher.MaxDiscount,
+		UsageLimit:   createVoucher.UsageLimit,
+		PerUserLimit: createVoucher.PerUserLimit,
+		ValidFrom:    createVoucher.ValidFrom,
+		ValidUntil:   createVoucher.ValidUntil,
+	}
+
+	if voucher.Type == discount.Percentage && voucher.Value > 10000 {
+		return dto.ResponseGetVoucher{}, fiber.NewError(
+			http.StatusBadRequest,
+			"percentage value is in basis points and must not exceed 10000",
+		)
+	}
+
+	if role == string(userrole.Admin) {
+		userID = uuid.Nil
+	} else if voucher.CanteenID == uuid.Nil {
+		return dto.ResponseGetVoucher{}, fiber.NewError(
+			http.StatusBadRequest,
+			"canteen id is required",
+		)
+	}
+
+	if voucher.MenuID != uuid.Nil && voucher.CanteenID == uuid.Nil {
+		return dto.ResponseGetVoucher{}, fiber.NewError(
+			http.StatusBadRequest,
+			"menu scoped voucher requires a canteen id",
+		)
+	}
+
+	err := c.canteenRepo.CreateVoucher(&voucher, userID)
+
+	return voucher.ParseToDTOResponseGetVoucher(), err
+}
+
+func (c *CanteenUseCase) CreatePromotion(createPromotion dto.CreatePromotion, userID uuid.UUID) (dto.ResponsePromotion, error) {
+	promotion := entity.Promotion{
+		ID:         uuid.New(),
+		MenuID:     createPromotion.MenuID,
+		Name:       createPromotion.Name,
+		Type:       discount.Type(createPromotion.Type),
+		Value:      createPromotion.Value,
+		ValidFrom:  createPromotion.ValidFrom,
+		ValidUntil: createPromotion.ValidUntil,
+	}
+
+	if promotion.Type == discount.Percentage && promotion.Value > 10000 {
+		return dto.ResponsePromotion{}, fiber.NewError(
+			http.StatusBadRequest,
+			"percentage value is in basis points and must not exceed 10000",
+		)
+	}
+
+	for _, day := range createPromotion.Weekdays {
+		promotion.Weekdays |= 1 << day
+	}
+
+	if createPromotion.StartTime != "" {
+		promotion.StartMinute = parseClock(createPromotion.StartTime)
+		promotion.EndMinute = parseClock(createPromotion.EndTime)
+	}
+
+	var menu entity.Menu
+
+	err := c.canteenRepo.CreatePromotion(&promotion, &menu, userID)
+	if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
+
+	return promotion.ParseToDTOResponsePromotion(), err
+}
+
+func (c *CanteenUseCase) CreateMenuAvailability(createMenuAvailability dto.CreateMenuAvailability, userID uuid.UUID) (dto.ResponseMenuAvailability, error) {
+	availability := entity.MenuAvailability{
+		ID:        uuid.New(),
+		MenuID:    createMenuAvailability.MenuID,
+		StartDate: createMenuAvailability.StartDate,
+		EndDate:   createMenuAvailability.EndDate,
+	}
+
+	if availability.StartDate != "" && availability.EndDate != "" && availability.EndDate < availability.StartDate {
+		return dto.ResponseMenuAvailability{}, fiber.NewError(
+			http.StatusBadRequest,
+			"end date must not be before start date",
+		)
+	}
+
+	for _, day := range createMenuAvailability.Weekdays {
+		availability.Weekdays |= 1 << day
+	}
+
+	if createMenuAvailability.StartTime != "" {
+		availability.StartMinute = parseClock(createMenuAvailability.StartTime)
+		availability.EndMinute = parseClock(createMenuAvailability.EndTime)
+	}
+
+	var menu entity.Menu
+
+	err := c.canteenRepo.CreateMenuAvailability(&availability, &menu, userID)
+	if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
+
+	return availability.ParseToDTOResponseMenuAvailability(), err
+}
+
+func (c *CanteenUseCase) CreateModifierGroup(createModifierGroup dto.CreateModifierGroup, userID uuid.UUID) (dto.ResponseModifierGroup, error) {
+	group := entity.ModifierGroup{
+		ID:          uuid.New(),
+		MenuID:      createModifierGroup.MenuID,
+		Name:        createModifierGroup.Name,
+		Required:    createModifierGroup.Required,
+		MultiSelect: createModifierGroup.MultiSelect,
+		MinSelect:   createModifierGroup.MinSelect,
+		MaxSelect:   createModifierGroup.MaxSelect,
+		Position:    createModifierGroup.Position,
+		Options:     make([]entity.ModifierOption, len(createModifierGroup.Options)),
+	}
+
+	for i, option := range createModifierGroup.Options {
+		group.Options[i] = entity.ModifierOption{
+			ID:         uuid.New(),
+			GroupID:    group.ID,
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+			Stock:      option.Stock,
+			Position:   uint32(i),
+		}
+	}
+
+	if !group.MultiSelect {
+		group.MaxSelect = 1
+	} else if group.MaxSelect == 0 || group.MaxSelect > uint32(len(group.Options)) {
+		group.MaxSelect = uint32(len(group.Options))
+	}
+
+	if !group.Required {
+		group.MinSelect = 0
+	} else if group.MinSelect == 0 {
+		group.MinSelect = 1
+	}
+
+	if group.MinSelect > group.MaxSelect {
+		return dto.ResponseModifierGroup{}, fiber.NewError(
+			http.StatusBadRequest,
+			"min select must not exceed max select",
+		)
+	}
+
+	var menu entity.Menu
+
+	err := c.canteenRepo.CreateModifierGroup(&group, &menu, userID)
+	if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
+
+	return group.ParseToDTOResponseModifierGroup(), err
+}
+
+func (c *CanteenUseCase) UpdateCanteen(updateCanteen dto.UpdateCanteen) (dto.ResponseUpdateCanteen, error) {
+	canteen := entity.Canteen{
+		ID:               updateCanteen.ID,
+		Name:             updateCanteen.Name,
+		AllowCashPayment: updateCanteen.AllowCashPayment,
+		ServiceFeeRate:   updateCanteen.ServiceFeeRate,
+		TaxRate:          updateCanteen.TaxRate,
+		RoundingUnit:     updateCanteen.RoundingUnit,
+		LoyaltyRate:      updateCanteen.LoyaltyRate,
+		Timezone:         updateCanteen.Timezone,
+		StockResetTime:   updateCanteen.StockResetTime,
+	}
+
+	err := c.canteenRepo.UpdateCanteen(&canteen, updateCanteen.UserID)
+
+	return canteen.ParseToDTOResponseUpdateCanteen(), err
+}
+
 func (c *CanteenUseCase) UpdateMenu(updateMenu dto.UpdateMenu) (dto.ResponseUpdateMenu, error) {
 	menu := entity.Menu{
-		ID:    updateMenu.ID,
-		Name:  updateMenu.Name,
-		Price: updateMenu.Price,
-		Stock: updateMenu.Stock,
+		ID:         updateMenu.ID,
+		CategoryID: updateMenu.CategoryID,
+		Name:       updateMenu.Name,
+		Position:   updateMenu.Position,
+		Price:      updateMenu.Price,
+		Stock:      updateMenu.Stock,
+		ParLevel:   updateMenu.ParLevel,
+	}
+
+	current := entity.Menu{
+		ID: updateMenu.ID,
+	}
+
+	if len(updateMenu.Image) != 0 {
+		err := c.canteenRepo.GetOwnedMenu(&current, updateMenu.UserID)
+		if err != nil {
+			return dto.ResponseUpdateMenu{}, err
+		}
+
+		variants, err := c.processMenuImage(&menu, updateMenu.Image)
+		if err != nil {
+			return dto.ResponseUpdateMenu{}, err
+		}
+
+		err = c.storeMenuImage(&menu, variants)
+		if err != nil {
+			return dto.ResponseUpdateMenu{}, err
+		}
 	}
 
 	err := c.canteenRepo.UpdateMenu(&menu, updateMenu.UserID)
+	if err != nil && menu.Image != "" {
+		c.deleteMenuImage(&menu)
+	}
+
+	if err != nil {
+		return menu.ParseToDTOResponseUpdateMenu(), err
+	}
+
+	c.invalidateMenuList(menu.CanteenID)
+
+	if menu.Image != "" {
+		c.deleteMenuImage(&current)
+	}
+
+	return menu.ParseToDTOResponseUpdateMenu(), nil
+}
+
+func (c *CanteenUseCase) UpdateModifierOption(updateModifierOption dto.UpdateModifierOption) (dto.ResponseModifierOption, error) {
+	option := entity.ModifierOption{
+		ID:         updateModifierOption.ID,
+		Name:       updateModifierOption.Name,
+		PriceDelta: updateModifierOption.PriceDelta,
+		Stock:      updateModifierOption.Stock,
+	}
+
+	var menu entity.Menu
+
+	err := c.canteenRepo.UpdateModifierOption(&option, &menu, updateModifierOption.UserID)
+	if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
+
+	return option.ParseToDTOResponseModifierOption(), err
+}
+
+func (c *CanteenUseCase) RestockMenu(restockMenu dto.RestockMenu) ([]dto.ResponseUpdateMenu, error) {
+	restock := make([]repository.Restock, len(restockMenu.Items))
+	seen := make(map[uuid.UUID]bool, len(restockMenu.Items))
+
+	for i, item := range restockMenu.Items {
+		if seen[item.MenuID] {
+			return nil, fiber.NewError(
+				http.StatusBadRequest,
+				"menu is listed more than once",
+			)
+		}
+
+		seen[item.MenuID] = true
+		restock[i] = repository.Restock{
+			MenuID:   item.MenuID,
+			Stock:    item.Stock,
+			ParLevel: item.ParLevel,
+		}
+	}
+
+	menu := new([]entity.Menu)
+
+	err := c.canteenRepo.RestockMenu(restock, menu, restockMenu.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedMenu := make([]dto.ResponseUpdateMenu, len(*menu))
+	invalidated := make(map[uuid.UUID]bool)
+
+	for i, m := range *menu {
+		parsedMenu[i] = m.ParseToDTOResponseUpdateMenu()
+
+		if !invalidated[m.CanteenID] {
+			invalidated[m.CanteenID] = true
+			c.invalidateMenuList(m.CanteenID)
+		}
+	}
+
+	return parsedMenu, err
+}
+
+func (c *CanteenUseCase) UpdateMenuCategory(updateMenuCategory dto.UpdateMenuCategory) (dto.ResponseMenuCategory, error) {
+	category := entity.MenuCategory{
+		ID:       updateMenuCategory.ID,
+		Name:     updateMenuCategory.Name,
+		Position: updateMenuCategory.Position,
+	}
+
+	err := c.canteenRepo.UpdateMenuCategory(&category, updateMenuCategory.UserID)
+	if err == nil {
+		c.invalidateMenuList(category.CanteenID)
+	}
 
-	return menu.ParseToDTOResponseUpdateMenu(), err
+	return category.ParseToDTOResponseMenuCategory(), err
 }
 
 func (c *CanteenUseCase) UpdateOrder(updateOrder dto.UpdateOrder, userID uuid.UUID) (dto.ResponseUpdateOrder, error) {
@@ -217,102 +845,1122 @@ func (c *CanteenUseCase) UpdateOrder(updateOrder dto.UpdateOrder, userID uuid.UU
 		Status: updateOrder.Status,
 	}
 
-	err := c.canteenRepo.UpdateOrder(&order, userID)
+	err := c.canteenRepo.UpdateOrder(&order, userID, uint32(c.Env.LoyaltyEarnBasisPoints))
 
 	return order.ParseToDTOResponseUpdateOrder(), err
 }
 
-func (c *CanteenUseCase) GetCanteenList() ([]dto.ResponseGetCanteenList, error) {
-	canteen := new([]entity.Canteen)
+func (c *CanteenUseCase) CancelOrder(cancelOrder dto.CancelOrder) error {
+	order := entity.Order{
+		ID:     cancelOrder.ID,
+		UserID: cancelOrder.UserID,
+	}
 
-	err := c.canteenRepo.GetCanteenList(canteen)
-	if err != nil {
-		return nil, err
+	err := c.canteenRepo.CancelOrder(&order)
+	if err == nil {
+		c.invalidateMenuList(order.CanteenID)
 	}
 
-	parsedCanteen := make([]dto.ResponseGetCanteenList, len(*canteen))
+	return err
+}
 
-	for i, c := range *canteen {
-		parsedCanteen[i] = c.ParseToDTOResponseGetCanteenList()
+func (c *CanteenUseCase) RejectOrder(rejectOrder dto.RejectOrder, userID uuid.UUID) (dto.ResponseUpdateOrder, error) {
+	order := entity.Order{
+		ID:     rejectOrder.ID,
+		Reason: rejectOrder.Reason,
 	}
 
-	return parsedCanteen, err
-}
+	refund := entity.Refund{
+		ID:          uuid.New(),
+		OrderID:     rejectOrder.ID,
+		Reason:      rejectOrder.Reason,
+		RequestedBy: userID,
+	}
 
-func (c *CanteenUseCase) GetCanteenInfo(canteenID uuid.UUID) (dto.ResponseGetCanteenInfo, error) {
-	canteen := entity.Canteen{
-		ID: canteenID,
+	err := c.canteenRepo.RejectOrder(&order, &refund, userID)
+	if err != nil {
+		return dto.ResponseUpdateOrder{}, err
 	}
 
-	err := c.canteenRepo.GetCanteenInfo(&canteen)
+	c.invalidateMenuList(order.CanteenID)
 
-	return canteen.ParseToDTOResponseGetCanteenInfo(), err
+	if refund.PaymentID == uuid.Nil {
+		return order.ParseToDTOResponseUpdateOrder(), nil
+	}
+
+	err = c.completeRefund(&refund)
+	if err != nil {
+		log.Println(err)
+	} else {
+		order.Status = orderstatus.Refunded
+	}
+
+	res := order.ParseToDTOResponseUpdateOrder()
+	parsedRefund := refund.ParseToDTOResponseGetRefund()
+	res.Refund = &parsedRefund
+
+	return res, nil
 }
 
-func (c *CanteenUseCase) GetMenuInfo(menuID uuid.UUID) (dto.ResponseGetMenuInfo, error) {
-	menu := entity.Menu{
-		ID: menuID,
+func (c *CanteenUseCase) ExpireOrders() error {
+	orders := new([]entity.Order)
+
+	createdBefore := time.Now().Add(-time.Duration(c.Env.OrderExpiryMinutes) * time.Minute)
+
+	err := c.canteenRepo.GetUnpaidOrderList(orders, createdBefore)
+	if err != nil {
+		return err
 	}
 
-	err := c.canteenRepo.GetMenuInfo(&menu)
+	for _, o := range *orders {
+		err := c.cancelPendingPayments(o.ID)
+		if err != nil {
+			log.Println(err)
+
+			continue
+		}
+
+		err = c.canteenRepo.ExpireOrder(&o)
+		if err != nil {
+			log.Println(err)
+
+			continue
+		}
+
+		c.invalidateMenuList(o.CanteenID)
+	}
 
-	return menu.ParseToDTOResponseGetMenuInfo(), err
+	return nil
 }
 
-func (c *CanteenUseCase) GetOrderInfo(getOrderInfo dto.GetOrderInfo) (dto.ResponseGetOrderInfo, error) {
-	order := entity.Order{
-		ID:     getOrderInfo.ID,
-		UserID: getOrderInfo.UserID,
+func (c *CanteenUseCase) cancelPendingPayments(orderID uuid.UUID) error {
+	payments := new([]entity.Payment)
+
+	err := c.canteenRepo.GetPendingOrderPaymentList(payments, orderID)
+	if err != nil {
+		return err
 	}
 
-	err := c.canteenRepo.GetOrderInfo(&order)
+	for _, p := range *payments {
+		if p.Method != paymentmethod.Gateway {
+			continue
+		}
 
-	return order.ParseToDTOResponseGetOrderInfo(), err
+		err = c.Payment.CancelPayment(p.ID.String())
+		if err != nil && err != payment.ErrTransactionNotFound {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (c *CanteenUseCase) GetOrderList(userID uuid.UUID) ([]dto.ResponseGetOrderList, error) {
-	order := new([]entity.Order)
+func (c *CanteenUseCase) ResetStock() error {
+	canteens := new([]entity.Canteen)
 
-	err := c.canteenRepo.GetOrderList(order, userID)
+	err := c.canteenRepo.GetStockResetCanteenList(canteens)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	parsedOrder := make([]dto.ResponseGetOrderList, len(*order))
+	for _, canteen := range *canteens {
+		now := time.Now().In(canteen.Location())
+		today := now.Format(time.DateOnly)
 
-	for i, o := range *order {
-		parsedOrder[i] = o.ParseToDTOResponseGetOrderList()
+		if canteen.LastStockReset == today || now.Format("15:04") < canteen.StockResetTime {
+			continue
+		}
+
+		err := c.canteenRepo.ResetStock(&canteen, today)
+		if err != nil {
+			log.Println(err)
+
+			continue
+		}
+
+		c.invalidateMenuList(canteen.ID)
 	}
 
-	return parsedOrder, err
+	return nil
 }
 
-func (c *CanteenUseCase) GetFeedback(feedbackID uuid.UUID) (dto.ResponseGetFeedback, error) {
-	feedback := entity.Feedback{
-		ID: feedbackID,
+func (c *CanteenUseCase) completeRefund(refund *entity.Refund) error {
+	payment := entity.Payment{
+		ID: refund.PaymentID,
 	}
 
-	err := c.canteenRepo.GetFeedback(&feedback)
+	err := c.canteenRepo.GetPayment(&payment)
+	if err != nil {
+		return err
+	}
 
-	return feedback.ParseToDTOResponseGetFeedback(), err
+	if payment.Method == paymentmethod.Gateway {
+		err = c.Payment.RefundPayment(dto.RefundPayment{
+			OrderID:   payment.ID.String(),
+			RefundKey: refund.ID.String(),
+			Amount:    refund.Amount,
+			Reason:    refund.Reason,
+		})
+		if err != nil {
+			attemptErr := c.canteenRepo.UpdateRefundAttempt(refund)
+			if attemptErr != nil {
+				log.Println(attemptErr)
+			}
+
+			return err
+		}
+	}
+
+	return c.canteenRepo.CompleteRefund(refund)
 }
 
-func (c *CanteenUseCase) SoftDeleteMenu(menuID uuid.UUID, userID uuid.UUID) error {
-	menu := entity.Menu{
-		ID: menuID,
+func (c *CanteenUseCase) ExpirePoints() error {
+	entries := new([]entity.LoyaltyEntry)
+
+	earnedBefore := time.Now().AddDate(0, 0, -c.Env.LoyaltyExpiryDays)
+
+	err := c.canteenRepo.GetExpiredPointsList(entries, earnedBefore)
+	if err != nil {
+		return err
 	}
 
-	err := c.canteenRepo.SoftDeleteMenu(&menu, userID)
+	for _, e := range *entries {
+		err := c.canteenRepo.ExpirePoints(&e)
+		if err != nil {
+			log.Println(err)
+		}
+	}
 
-	return err
+	return nil
 }
 
-func (c *CanteenUseCase) SoftDeleteFeedback(feedbackID uuid.UUID, userID uuid.UUID) error {
-	feedback := entity.Feedback{
-		ID: feedbackID,
+func (c *CanteenUseCase) ReconcilePayments() error {
+	payments := new([]entity.Payment)
+
+	err := c.canteenRepo.GetPendingPaymentList(payments)
+	if err != nil {
+		return err
 	}
 
-	err := c.canteenRepo.SoftDeleteFeedback(&feedback, userID)
+	report := entity.ReconciliationReport{
+		ID:      uuid.New(),
+		Entries: make([]entity.ReconciliationEntry, len(*payments)),
+	}
+
+	for i, p := range *payments {
+		entry := entity.ReconciliationEntry{
+			ID:          uuid.New(),
+			ReportID:    report.ID,
+			PaymentID:   p.ID,
+			LocalStatus: p.Status,
+			LocalAmount: p.Price,
+			Result:      reconciliation.Failed,
+		}
+
+		status, err := c.Payment.GetPaymentStatus(p.ID.String())
+		if err != nil {
+			report.Failed++
+			report.Entries[i] = entry
+
+			continue
+		}
+
+		entry.ProviderStatus = status.TransactionStatus
+
+		entry.ProviderAmount, err = parseGrossAmount(status.GrossAmount)
+		if err != nil {
+			log.Println(err)
+
+			report.Failed++
+			report.Entries[i] = entry
+
+			continue
+		}
+
+		providerStatus := paymentstatus.FromTransactionStatus(status.TransactionStatus)
+
+		switch {
+		case providerStatus == p.Status:
+			entry.Result = reconciliation.Matched
+			report.Matched++
+		case entry.ProviderAmount != entry.LocalAmount:
+			entry.Result = reconciliation.Mismatched
+			report.Mismatched++
+		default:
+			p.Status = providerStatus
+			p.TransactionID = status.TransactionID
+
+			refund := entity.Refund{
+				ID:     uuid.New(),
+				Reason: lateSettlementReason,
+			}
+
+			err := c.canteenRepo.VerifyPayment(&p, &refund)
+			if err != nil {
+				log.Println(err)
+
+				report.Failed++
+
+				break
+			}
+
+			c.refundLateSettlement(&refund)
+
+			entry.Result = reconciliation.Fixed
+			report.Fixed++
+		}
+
+		report.Entries[i] = entry
+	}
+
+	err = c.canteenRepo.CreateReconciliationReport(&report)
+	if err != nil {
+		return err
+	}
+
+	log.Printf(
+		"payment reconciliation complete: %d matched, %d fixed, %d mismatched, %d failed",
+		report.Matched,
+		report.Fixed,
+		report.Mismatched,
+		report.Failed,
+	)
+
+	return nil
+}
+
+func (c *CanteenUseCase) GeneratePayouts(period settlement.Period) ([]dto.ResponseGetPayout, error) {
+	canteens := new([]entity.Canteen)
+
+	err := c.canteenRepo.GetCanteenList(canteens)
+	if err != nil {
+		return nil, err
+	}
+
+	periodStart, periodEnd := period.Window(time.Now())
+
+	parsedPayout := make([]dto.ResponseGetPayout, 0, len(*canteens))
+
+	for _, canteen := range *canteens {
+		payout := entity.Payout{
+			ID:             uuid.New(),
+			CanteenID:      canteen.ID,
+			Period:         period,
+			PeriodStart:    periodStart,
+			PeriodEnd:      periodEnd,
+			CommissionRate: uint32(c.Env.PlatformCommissionBasisPoints),
+			Status:         settlement.Pending,
+		}
+
+		err := c.canteenRepo.CreatePayout(&payout)
+		if err == gorm.ErrRecordNotFound {
+			continue
+		} else if err != nil {
+			log.Println(err)
+
+			continue
+		}
+
+		parsedPayout = append(parsedPayout, payout.ParseToDTOResponseGetPayout())
+	}
+
+	return parsedPayout, nil
+}
+
+func (c *CanteenUseCase) SettlePayouts() error {
+	payouts, err := c.GeneratePayouts(settlement.Period(c.Env.PayoutPeriod))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("payout settlement complete: %d payouts generated", len(payouts))
+
+	return nil
+}
+
+func (c *CanteenUseCase) MarkPayoutPaid(payoutID uuid.UUID, userID uuid.UUID) (dto.ResponseGetPayout, error) {
+	payout := entity.Payout{
+		ID:     payoutID,
+		PaidBy: userID,
+	}
+
+	err := c.canteenRepo.UpdatePayoutPaid(&payout)
+
+	return payout.ParseToDTOResponseGetPayout(), err
+}
+
+func (c *CanteenUseCase) GetCanteenList() ([]dto.ResponseGetCanteenList, error) {
+	canteen := new([]entity.Canteen)
+
+	err := c.canteenRepo.GetCanteenList(canteen)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCanteen := make([]dto.ResponseGetCanteenList, len(*canteen))
+
+	for i, c := range *canteen {
+		parsedCanteen[i] = c.ParseToDTOResponseGetCanteenList()
+	}
+
+	return parsedCanteen, err
+}
+
+func (c *CanteenUseCase) GetCanteenInfo(canteenID uuid.UUID) (dto.ResponseGetCanteenInfo, error) {
+	canteen := entity.Canteen{
+		ID: canteenID,
+	}
+
+	err := c.canteenRepo.GetCanteenInfo(&canteen)
+
+	return canteen.ParseToDTOResponseGetCanteenInfo(), err
+}
+
+func (c *CanteenUseCase) GetMenuInfo(menuID uuid.UUID) (dto.ResponseGetMenuInfo, error) {
+	menu := entity.Menu{
+		ID: menuID,
+	}
+
+	err := c.canteenRepo.GetMenuInfo(&menu)
+	if err != nil {
+		return dto.ResponseGetMenuInfo{}, err
+	}
+
+	now, err := c.canteenTime(menu.CanteenID)
+
+	return menu.ParseToDTOResponseGetMenuInfo(now), err
+}
+
+func (c *CanteenUseCase) GetMenuCategoryList(canteenID uuid.UUID) ([]dto.ResponseMenuCategory, error) {
+	category := new([]entity.MenuCategory)
+
+	err := c.canteenRepo.GetMenuCategoryList(category, canteenID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCategory := make([]dto.ResponseMenuCategory, len(*category))
+
+	for i, m := range *category {
+		parsedCategory[i] = m.ParseToDTOResponseMenuCategory()
+	}
+
+	return parsedCategory, err
+}
+
+func (c *CanteenUseCase) GetCanteenMenu(canteenID uuid.UUID) (dto.ResponseGetCanteenMenu, error) {
+	category := new([]entity.MenuCategory)
+	menu := new([]entity.Menu)
+
+	err := c.canteenRepo.GetMenuCategoryList(category, canteenID)
+	if err != nil {
+		return dto.ResponseGetCanteenMenu{}, err
+	}
+
+	err = c.canteenRepo.GetCanteenMenu(menu, canteenID)
+	if err != nil {
+		return dto.ResponseGetCanteenMenu{}, err
+	}
+
+	now, err := c.canteenTime(canteenID)
+	if err != nil {
+		return dto.ResponseGetCanteenMenu{}, err
+	}
+
+	groups := make([]dto.ResponseMenuGroup, 0, len(*category)+1)
+	index := make(map[uuid.UUID]int, len(*category))
+
+	for _, m := range *category {
+		index[m.ID] = len(groups)
+		groups = append(groups, dto.ResponseMenuGroup{
+			CategoryID: m.ID,
+			Name:       m.Name,
+			Position:   m.Position,
+			Menus:      []dto.ResponseGetMenuInfo{},
+		})
+	}
+
+	var uncategorized []dto.ResponseGetMenuInfo
+
+	for _, m := range *menu {
+		i, ok := index[m.CategoryID]
+		if !ok {
+			uncategorized = append(uncategorized, m.ParseToDTOResponseGetMenuInfo(now))
+
+			continue
+		}
+
+		groups[i].Menus = append(groups[i].Menus, m.ParseToDTOResponseGetMenuInfo(now))
+	}
+
+	if len(uncategorized) != 0 {
+		groups = append(groups, dto.ResponseMenuGroup{
+			Name:  "Uncategorized",
+			Menus: uncategorized,
+		})
+	}
+
+	return dto.ResponseGetCanteenMenu{
+		CanteenID: canteenID,
+		Groups:    groups,
+	}, nil
+}
+
+func (c *CanteenUseCase) GetMenuList(getMenuList dto.GetMenuList) (dto.ResponseGetMenuList, error) {
+	var cached struct {
+		Menus []entity.Menu
+		Total int64
+	}
+	var availableAt string
+
+	now, err := c.canteenTime(getMenuList.CanteenID)
+	if err != nil {
+		return dto.ResponseGetMenuList{}, err
+	}
+
+	if getMenuList.Available {
+		availableAt = now.Format("200601021504")
+	}
+
+	if getMenuList.Page == 0 {
+		getMenuList.Page = 1
+	}
+
+	if getMenuList.Limit == 0 {
+		getMenuList.Limit = 20
+	}
+
+	version, err := c.redis.Get(menuListVersionKey(getMenuList.CanteenID))
+	if err != nil {
+		version = "0"
+	}
+
+	key := fmt.Sprintf(
+		"menu:list:%s:%s:%d:%d:%s:%s:%t:%s:%d:%d",
+		getMenuList.CanteenID.String(),
+		version,
+		getMenuList.Page,
+		getMenuList.Limit,
+		getMenuList.Sort,
+		getMenuList.Order,
+		getMenuList.InStock,
+		availableAt,
+		getMenuList.MinPrice,
+		getMenuList.MaxPrice,
+	)
+
+	result, err := c.redis.Get(key)
+	if err == nil && result != "" {
+		err = json.Unmarshal([]byte(result), &cached)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	if err != nil || result == "" {
+		menu := new([]entity.Menu)
+
+		err = c.canteenRepo.GetMenuList(menu, &cached.Total, repository.MenuFilter{
+			CanteenID:  getMenuList.CanteenID,
+			Sort:       getMenuList.Sort,
+			Descending: getMenuList.Order == "desc",
+			InStock:    getMenuList.InStock,
+			Available:  getMenuList.Available,
+			Now:        now,
+			MinPrice:   getMenuList.MinPrice,
+			MaxPrice:   getMenuList.MaxPrice,
+			Offset:     (getMenuList.Page - 1) * getMenuList.Limit,
+			Limit:      getMenuList.Limit,
+		})
+		if err != nil {
+			return dto.ResponseGetMenuList{}, err
+		}
+
+		cached.Menus = *menu
+
+		go func() {
+			newData, err := json.Marshal(cached)
+			if err != nil {
+				log.Println(err)
+			}
+			c.redis.SetWithTTL(key, string(newData), time.Duration(c.Env.MenuCacheSeconds)*time.Second)
+		}()
+	}
+
+	parsedMenu := make([]dto.ResponseGetMenuInfo, len(cached.Menus))
+
+	for i, m := range cached.Menus {
+		parsedMenu[i] = m.ParseToDTOResponseGetMenuInfo(now)
+	}
+
+	return dto.ResponseGetMenuList{
+		Menus: parsedMenu,
+		Page:  getMenuList.Page,
+		Limit: getMenuList.Limit,
+		Total: cached.Total,
+	}, nil
+}
+
+func (c *CanteenUseCase) canteenTime(canteenID uuid.UUID) (time.Time, error) {
+	canteen := entity.Canteen{
+		ID: canteenID,
+	}
+
+	err := c.canteenRepo.GetCanteenInfo(&canteen)
+
+	return time.Now().In(canteen.Location()), err
+}
+
+func (c *CanteenUseCase) processMenuImage(menu *entity.Menu, data []byte) (imaging.Variants, error) {
+	variants, err := imaging.Process(data)
+	if err == imaging.ErrUnsupportedImage {
+		return imaging.Variants{}, fiber.NewError(
+			http.StatusUnsupportedMediaType,
+			err.Error(),
+		)
+	} else if err == imaging.ErrImageTooLarge {
+		return imaging.Variants{}, fiber.NewError(
+			http.StatusUnprocessableEntity,
+			err.Error(),
+		)
+	} else if err != nil {
+		return imaging.Variants{}, err
+	}
+
+	imageID := uuid.New()
+
+	menu.Image = c.Storage.URL(menuImageKey(menu.ID, imageID, "full"))
+	menu.Thumbnail = c.Storage.URL(menuImageKey(menu.ID, imageID, "thumbnail"))
+
+	return variants, nil
+}
+
+func (c *CanteenUseCase) storeMenuImage(menu *entity.Menu, variants imaging.Variants) error {
+	err := c.Storage.Put(c.storageKey(menu.Image), "image/jpeg", variants.Full)
+	if err != nil {
+		return err
+	}
+
+	err = c.Storage.Put(c.storageKey(menu.Thumbnail), "image/jpeg", variants.Thumbnail)
+
+	return err
+}
+
+func (c *CanteenUseCase) deleteMenuImage(menu *entity.Menu) {
+	for _, url := range []string{menu.Image, menu.Thumbnail} {
+		if url == "" {
+			continue
+		}
+
+		err := c.Storage.Delete(c.storageKey(url))
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+func (c *CanteenUseCase) storageKey(url string) string {
+	return strings.TrimPrefix(url, c.Storage.URL(""))
+}
+
+func menuImageKey(menuID uuid.UUID, imageID uuid.UUID, variant string) string {
+	return fmt.Sprintf("menu/%s/%s/%s.jpg", menuID.String(), imageID.String(), variant)
+}
+
+func (c *CanteenUseCase) invalidateMenuList(canteenID uuid.UUID) {
+	c.redis.Incr(menuListVersionKey(canteenID))
+}
+
+func menuListVersionKey(canteenID uuid.UUID) string {
+	return fmt.Sprintf("menu:version:%s", canteenID.String())
+}
+
+func (c *CanteenUseCase) GetPromotionList(menuID uuid.UUID) ([]dto.ResponsePromotion, error) {
+	promotion := new([]entity.Promotion)
+
+	err := c.canteenRepo.GetPromotionList(promotion, menuID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPromotion := make([]dto.ResponsePromotion, len(*promotion))
+
+	for i, p := range *promotion {
+		parsedPromotion[i] = p.ParseToDTOResponsePromotion()
+	}
+
+	return parsedPromotion, err
+}
+
+func (c *CanteenUseCase) GetMenuAvailabilityList(menuID uuid.UUID) ([]dto.ResponseMenuAvailability, error) {
+	availability := new([]entity.MenuAvailability)
+
+	err := c.canteenRepo.GetMenuAvailabilityList(availability, menuID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAvailability := make([]dto.ResponseMenuAvailability, len(*availability))
+
+	for i, a := range *availability {
+		parsedAvailability[i] = a.ParseToDTOResponseMenuAvailability()
+	}
+
+	return parsedAvailability, err
+}
+
+func (c *CanteenUseCase) GetModifierGroupList(menuID uuid.UUID) ([]dto.ResponseModifierGroup, error) {
+	group := new([]entity.ModifierGroup)
+
+	err := c.canteenRepo.GetModifierGroupList(group, menuID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedGroup := make([]dto.ResponseModifierGroup, len(*group))
+
+	for i, g := range *group {
+		parsedGroup[i] = g.ParseToDTOResponseModifierGroup()
+	}
+
+	return parsedGroup, err
+}
+
+func (c *CanteenUseCase) GetOrderInfo(getOrderInfo dto.GetOrderInfo) (dto.ResponseGetOrderInfo, error) {
+	order := entity.Order{
+		ID:     getOrderInfo.ID,
+		UserID: getOrderInfo.UserID,
+	}
+
+	err := c.canteenRepo.GetOrderInfo(&order)
+
+	return order.ParseToDTOResponseGetOrderInfo(), err
+}
+
+func (c *CanteenUseCase) GetOrderList(userID uuid.UUID) ([]dto.ResponseGetOrderList, error) {
+	order := new([]entity.Order)
+
+	err := c.canteenRepo.GetOrderList(order, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedOrder := make([]dto.ResponseGetOrderList, len(*order))
+
+	for i, o := range *order {
+		parsedOrder[i] = o.ParseToDTOResponseGetOrderList()
+	}
+
+	return parsedOrder, err
+}
+
+func (c *CanteenUseCase) GetReceipt(getOrderInfo dto.GetOrderInfo) (dto.ResponseGetReceipt, error) {
+	order := entity.Order{
+		ID:     getOrderInfo.ID,
+		UserID: getOrderInfo.UserID,
+	}
+
+	err := c.canteenRepo.GetOrderInfo(&order)
+	if err != nil {
+		return dto.ResponseGetReceipt{}, err
+	}
+
+	if order.ReceiptNumber == "" {
+		return dto.ResponseGetReceipt{}, fiber.NewError(
+			http.StatusConflict,
+			"receipt is issued once the order is paid",
+		)
+	}
+
+	canteen := entity.Canteen{
+		ID: order.CanteenID,
+	}
+
+	err = c.canteenRepo.GetCanteenInfo(&canteen)
+	if err != nil {
+		return dto.ResponseGetReceipt{}, err
+	}
+
+	payment := entity.Payment{
+		OrderID: order.ID,
+	}
+
+	err = c.canteenRepo.GetSettledOrderPayment(&payment)
+	if err != nil {
+		return dto.ResponseGetReceipt{}, err
+	}
+
+	return order.ParseToDTOResponseGetReceipt(canteen, payment), nil
+}
+
+func (c *CanteenUseCase) GetReceiptPDF(getOrderInfo dto.GetOrderInfo) ([]byte, error) {
+	receipt, err := c.GetReceipt(getOrderInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	document := pdf.New()
+
+	document.Text(16, receipt.CanteenName)
+	document.Text(10, "Receipt "+receipt.ReceiptNumber)
+	document.Gap(10)
+	document.Row(10, "Order", receipt.OrderID.String())
+	document.Row(10, "Ordered at", receipt.OrderedAt.Format(time.DateTime))
+
+	if receipt.PaidAt != nil {
+		document.Row(10, "Paid at", receipt.PaidAt.Format(time.DateTime))
+	}
+
+	document.Row(10, "Payment method", receipt.PaymentMethod)
+	document.Gap(10)
+
+	for _, item := range receipt.Items {
+		document.Text(10, item.Name)
+
+		for _, option := range item.Options {
+			document.Text(10, "  + "+option)
+		}
+
+		document.Row(10, fmt.Sprintf("  %d x %s", item.Quantity, formatRupiah(item.UnitPrice)), formatRupiah(item.Amount))
+	}
+
+	document.Gap(10)
+	document.Row(10, "Subtotal", formatRupiah(receipt.Subtotal))
+
+	if receipt.Discount != 0 {
+		document.Row(10, "Discount "+receipt.VoucherCode, "-"+formatRupiah(receipt.Discount))
+	}
+
+	if receipt.PointsUsed != 0 {
+		document.Row(10, "Loyalty points", "-"+formatRupiah(receipt.PointsUsed))
+	}
+
+	if receipt.ServiceFee != 0 {
+		document.Row(10, "Service fee", formatRupiah(receipt.ServiceFee))
+	}
+
+	if receipt.Tax != 0 {
+		document.Row(10, "Tax", formatRupiah(receipt.Tax))
+	}
+
+	if receipt.Rounding < 0 {
+		document.Row(10, "Rounding", "-"+formatRupiah(uint32(-receipt.Rounding)))
+	} else if receipt.Rounding > 0 {
+		document.Row(10, "Rounding", formatRupiah(uint32(receipt.Rounding)))
+	}
+
+	document.Row(12, "Total", formatRupiah(receipt.Total))
+
+	return document.Bytes(), nil
+}
+
+func (c *CanteenUseCase) GetPaymentNotificationList() ([]dto.ResponseGetPaymentNotification, error) {
+	notification := new([]entity.PaymentNotification)
+
+	err := c.canteenRepo.GetPaymentNotificationList(notification)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedNotification := make([]dto.ResponseGetPaymentNotification, len(*notification))
+
+	for i, n := range *notification {
+		parsedNotification[i] = n.ParseToDTOResponseGetPaymentNotification()
+	}
+
+	return parsedNotification, err
+}
+
+func (c *CanteenUseCase) GetRefundList(userID uuid.UUID) ([]dto.ResponseGetRefund, error) {
+	refund := new([]entity.Refund)
+
+	err := c.canteenRepo.GetRefundList(refund, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedRefund := make([]dto.ResponseGetRefund, len(*refund))
+
+	for i, r := range *refund {
+		parsedRefund[i] = r.ParseToDTOResponseGetRefund()
+	}
+
+	return parsedRefund, err
+}
+
+func (c *CanteenUseCase) GetPayout(payoutID uuid.UUID, userID uuid.UUID, role string) (dto.ResponseGetPayout, error) {
+	payout := entity.Payout{
+		ID: payoutID,
+	}
+
+	if role == string(userrole.Admin) {
+		userID = uuid.Nil
+	}
+
+	err := c.canteenRepo.GetPayout(&payout, userID)
+
+	return payout.ParseToDTOResponseGetPayout(), err
+}
+
+func (c *CanteenUseCase) GetPayoutList(userID uuid.UUID, role string) ([]dto.ResponseGetPayout, error) {
+	payout := new([]entity.Payout)
+
+	if role == string(userrole.Admin) {
+		userID = uuid.Nil
+	}
+
+	err := c.canteenRepo.GetPayoutList(payout, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPayout := make([]dto.ResponseGetPayout, len(*payout))
+
+	for i, p := range *payout {
+		parsedPayout[i] = p.ParseToDTOResponseGetPayout()
+	}
+
+	return parsedPayout, err
+}
+
+func (c *CanteenUseCase) GetVoucherList(userID uuid.UUID, role string) ([]dto.ResponseGetVoucher, error) {
+	voucher := new([]entity.Voucher)
+
+	if role == string(userrole.Admin) {
+		userID = uuid.Nil
+	}
+
+	err := c.canteenRepo.GetVoucherList(voucher, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedVoucher := make([]dto.ResponseGetVoucher, len(*voucher))
+
+	for i, v := range *voucher {
+		parsedVoucher[i] = v.ParseToDTOResponseGetVoucher()
+	}
+
+	return parsedVoucher, err
+}
+
+func (c *CanteenUseCase) GetReconciliationReport(reportID uuid.UUID) (dto.ResponseGetReconciliationReport, error) {
+	report := entity.ReconciliationReport{
+		ID: reportID,
+	}
+
+	err := c.canteenRepo.GetReconciliationReport(&report)
+
+	return report.ParseToDTOResponseGetReconciliationReport(), err
+}
+
+func (c *CanteenUseCase) GetReconciliationReportList() ([]dto.ResponseGetReconciliationReport, error) {
+	report := new([]entity.ReconciliationReport)
+
+	err := c.canteenRepo.GetReconciliationReportList(report)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedReport := make([]dto.ResponseGetReconciliationReport, len(*report))
+
+	for i, r := range *report {
+		parsedReport[i] = r.ParseToDTOResponseGetReconciliationReport()
+	}
+
+	return parsedReport, err
+}
+
+func (c *CanteenUseCase) GetFeedback(feedbackID uuid.UUID) (dto.ResponseGetFeedback, error) {
+	feedback := entity.Feedback{
+		ID: feedbackID,
+	}
+
+	err := c.canteenRepo.GetFeedback(&feedback)
+
+	return feedback.ParseToDTOResponseGetFeedback(), err
+}
+
+func (c *CanteenUseCase) SoftDeleteMenu(menuID uuid.UUID, userID uuid.UUID) error {
+	menu := entity.Menu{
+		ID: menuID,
+	}
+
+	err := c.canteenRepo.SoftDeleteMenu(&menu, userID)
+	if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
+
+	return err
+}
+
+func (c *CanteenUseCase) SoftDeleteMenuCategory(categoryID uuid.UUID, userID uuid.UUID) error {
+	category := entity.MenuCategory{
+		ID: categoryID,
+	}
+
+	err := c.canteenRepo.SoftDeleteMenuCategory(&category, userID)
+	if err == nil {
+		c.invalidateMenuList(category.CanteenID)
+	}
+
+	return err
+}
+
+func (c *CanteenUseCase) SoftDeleteFeedback(feedbackID uuid.UUID, userID uuid.UUID) error {
+	feedback := entity.Feedback{
+		ID: feedbackID,
+	}
+
+	err := c.canteenRepo.SoftDeleteFeedback(&feedback, userID)
+
+	return err
+}
+
+func (c *CanteenUseCase) SoftDeleteVoucher(voucherID uuid.UUID, userID uuid.UUID, role string) error {
+	voucher := entity.Voucher{
+		ID: voucherID,
+	}
+
+	if role == string(userrole.Admin) {
+		userID = uuid.Nil
+	}
+
+	err := c.canteenRepo.SoftDeleteVoucher(&voucher, userID)
+
+	return err
+}
+
+func (c *CanteenUseCase) SoftDeletePromotion(promotionID uuid.UUID, userID uuid.UUID) error {
+	promotion := entity.Promotion{
+		ID: promotionID,
+	}
+
+	var menu entity.Menu
+
+	err := c.canteenRepo.SoftDeletePromotion(&promotion, &menu, userID)
+	if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
+
+	return err
+}
+
+func (c *CanteenUseCase) SoftDeleteMenuAvailability(availabilityID uuid.UUID, userID uuid.UUID) error {
+	availability := entity.MenuAvailability{
+		ID: availabilityID,
+	}
+
+	var menu entity.Menu
+
+	err := c.canteenRepo.SoftDeleteMenuAvailability(&availability, &menu, userID)
+	if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
 
 	return err
 }
+
+func (c *CanteenUseCase) SoftDeleteModifierGroup(groupID uuid.UUID, userID uuid.UUID) error {
+	group := entity.ModifierGroup{
+		ID: groupID,
+	}
+
+	var menu entity.Menu
+
+	err := c.canteenRepo.SoftDeleteModifierGroup(&group, &menu, userID)
+	if err == nil {
+		c.invalidateMenuList(menu.CanteenID)
+	}
+
+	return err
+}
+
+func (c *CanteenUseCase) processPaymentNotification(notification *entity.PaymentNotification) error {
+	paymentID, err := uuid.Parse(notification.OrderID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid order id",
+		)
+	}
+
+	payment := entity.Payment{
+		ID: paymentID,
+	}
+
+	err = c.canteenRepo.GetPayment(&payment)
+	if err != nil {
+		return err
+	}
+
+	payment.Status = paymentstatus.FromTransactionStatus(notification.TransactionStatus)
+	payment.TransactionID = notification.TransactionID
+
+	if payment.Status == paymentstatus.Settled {
+		grossAmount, err := parseGrossAmount(notification.GrossAmount)
+		if err != nil || grossAmount != payment.Price {
+			return fiber.NewError(
+				http.StatusUnprocessableEntity,
+				"gross amount mismatch",
+			)
+		}
+	}
+
+	refund := entity.Refund{
+		ID:     uuid.New(),
+		Reason: lateSettlementReason,
+	}
+
+	err = c.canteenRepo.VerifyPayment(&payment, &refund)
+	if err != nil {
+		return err
+	}
+
+	notification.Processed = true
+
+	err = c.canteenRepo.UpdatePaymentNotification(notification)
+	if err != nil {
+		return err
+	}
+
+	c.refundLateSettlement(&refund)
+
+	return nil
+}
+
+func (c *CanteenUseCase) refundLateSettlement(refund *entity.Refund) {
+	if refund.PaymentID == uuid.Nil {
+		return
+	}
+
+	err := c.completeRefund(refund)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func parseGrossAmount(grossAmount string) (uint32, error) {
+	amount, err := strconv.ParseFloat(grossAmount, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(math.Round(amount)), nil
+}
+
+func parseClock(clock string) uint16 {
+	t, _ := time.Parse("15:04", clock)
+
+	return uint16(t.Hour()*60 + t.Minute())
+}
+
+func formatRupiah(amount uint32) string {
+	digits := strconv.FormatUint(uint64(amount), 10)
+
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "." + digits[i:]
+	}
+
+	return "Rp " + digits
+}
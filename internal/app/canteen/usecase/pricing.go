@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/entity"
+)
+
+func priceOrder(order *entity.Order, canteen entity.Canteen) {
+	order.Subtotal = order.CalculateSubtotal()
+
+	discounted := order.Subtotal - order.Discount - order.PointsUsed
+
+	order.ServiceFee = applyRate(discounted, canteen.ServiceFeeRate)
+	order.Tax = applyRate(discounted+order.ServiceFee, canteen.TaxRate)
+
+	total := discounted + order.ServiceFee + order.Tax
+
+	order.Total = roundHalfUp(total, canteen.RoundingUnit)
+	order.Rounding = int32(int64(order.Total) - int64(total))
+}
+
+func itemDetails(order entity.Order) []dto.ItemDetail {
+	details := make([]dto.ItemDetail, 0, len(order.OrderItems)+5)
+
+	for _, item := range order.OrderItems {
+		details = append(details, dto.ItemDetail{
+			ID:       item.MenuID.String(),
+			Name:     item.Name,
+			Price:    int64(item.Price),
+			Quantity: int32(item.Quantity),
+		})
+	}
+
+	adjustments := []dto.ItemDetail{
+		{ID: "discount", Name: "Voucher " + order.VoucherCode, Price: -int64(order.Discount), Quantity: 1},
+		{ID: "points", Name: "Loyalty points", Price: -int64(order.PointsUsed), Quantity: 1},
+		{ID: "service-fee", Name: "Service fee", Price: int64(order.ServiceFee), Quantity: 1},
+		{ID: "tax", Name: "Tax", Price: int64(order.Tax), Quantity: 1},
+		{ID: "rounding", Name: "Rounding", Price: int64(order.Rounding), Quantity: 1},
+	}
+
+	for _, adjustment := range adjustments {
+		if adjustment.Price != 0 {
+			details = append(details, adjustment)
+		}
+	}
+
+	return details
+}
+
+func applyRate(amount uint32, rate uint32) uint32 {
+	return uint32((uint64(amount)*uint64(rate) + 5000) / 10000)
+}
+
+func roundHalfUp(amount uint32, unit uint32) uint32 {
+	if unit <= 1 {
+		return amount
+	}
+
+	return (amount + unit/2) / unit * unit
+}
@@ -37,6 +37,10 @@ func NewUserHandler(
 	routerGroup.Post("/register", userHandler.Register)
 	routerGroup.Post("/login", userHandler.Login)
 	routerGroup.Get("/info", middleware.Authentication, userHandler.GetUserInfo)
+	routerGroup.Get("/wallet", middleware.Authentication, userHandler.GetWallet)
+	routerGroup.Get("/wallet/statement", middleware.Authentication, userHandler.GetWalletStatement)
+	routerGroup.Get("/points", middleware.Authentication, userHandler.GetPoints)
+	routerGroup.Get("/points/history", middleware.Authentication, userHandler.GetPointsHistory)
 	routerGroup.Patch("", middleware.Authentication, userHandler.UpdateUserInfo)
 	routerGroup.Patch("/role", middleware.Authentication, middleware.Admin, userHandler.UpdateUserRole)
 	routerGroup.Delete("/:username", middleware.Authentication, middleware.Admin, userHandler.SoftDelete)
@@ -231,6 +235,98 @@ func (u *UserHandler) GetUserInfo(ctx *fiber.Ctx) error {
 	})
 }
 
+func (u *UserHandler) GetWallet(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	res, err := u.UserUseCase.GetWallet(userID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get wallet",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "retrieved wallet",
+		"payload": res,
+	})
+}
+
+func (u *UserHandler) GetWalletStatement(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	res, err := u.UserUseCase.GetWalletStatement(userID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get wallet statement",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "retrieved wallet statement",
+		"payload": res,
+	})
+}
+
+func (u *UserHandler) GetPoints(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	res, err := u.UserUseCase.GetPoints(userID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get loyalty points",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "retrieved loyalty points",
+		"payload": res,
+	})
+}
+
+func (u *UserHandler) GetPointsHistory(ctx *fiber.Ctx) error {
+	userID, err := uuid.Parse(ctx.Locals("userID").(string))
+	if err != nil {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"user unauthorized",
+		)
+	}
+
+	res, err := u.UserUseCase.GetPointsHistory(userID)
+	if err != nil {
+		return fiber.NewError(
+			http.StatusInternalServerError,
+			"failed to get loyalty points history",
+		)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"message": "retrieved loyalty points history",
+		"payload": res,
+	})
+}
+
 func (u *UserHandler) SoftDelete(ctx *fiber.Ctx) error {
 	targetUserName := ctx.Params("username")
 	userIDTarget, err := u.UserUseCase.GetUserIDFromUsername(targetUserName)
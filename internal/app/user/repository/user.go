@@ -4,6 +4,7 @@ package repository
 import (
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/entity"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -18,6 +19,10 @@ type UserDBItf interface {
 	GetUserIDFromUsername(user *entity.User) error
 	GetUsername(user *entity.User, userParam dto.Login) error
 	GetUserInfo(user *entity.User) error
+	GetWallet(wallet *entity.Wallet) error
+	GetLedgerEntryList(entry *[]entity.LedgerEntry, account string) error
+	GetLoyaltyAccount(account *entity.LoyaltyAccount) error
+	GetLoyaltyEntryList(entry *[]entity.LoyaltyEntry, userID uuid.UUID) error
 	SoftDelete(user *entity.User) error
 }
 
@@ -110,6 +115,36 @@ func (r *UserDB) GetUserIDFromUsername(user *entity.User) error {
 		Error
 }
 
+func (r *UserDB) GetWallet(wallet *entity.Wallet) error {
+	return r.db.Debug().
+		Where("user_id = ?", wallet.UserID).
+		First(wallet).
+		Error
+}
+
+func (r *UserDB) GetLedgerEntryList(entry *[]entity.LedgerEntry, account string) error {
+	return r.db.Debug().
+		Where("account = ?", account).
+		Order("created_at DESC").
+		Find(entry).
+		Error
+}
+
+func (r *UserDB) GetLoyaltyAccount(account *entity.LoyaltyAccount) error {
+	return r.db.Debug().
+		Where("user_id = ?", account.UserID).
+		First(account).
+		Error
+}
+
+func (r *UserDB) GetLoyaltyEntryList(entry *[]entity.LoyaltyEntry, userID uuid.UUID) error {
+	return r.db.Debug().
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(entry).
+		Error
+}
+
 func (r *UserDB) SoftDelete(user *entity.User) error {
 	return r.db.Debug().
 		Delete(user).
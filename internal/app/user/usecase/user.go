@@ -10,10 +10,12 @@ import (
 	"github.com/SyafaHadyan/freepass-2026/internal/app/user/repository"
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/entity"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/ledger"
 	"github.com/SyafaHadyan/freepass-2026/internal/infra/jwt"
 	redisitf "github.com/SyafaHadyan/freepass-2026/internal/infra/redis"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 type UserUseCaseItf interface {
@@ -23,6 +25,10 @@ type UserUseCaseItf interface {
 	Login(login dto.Login) (dto.ResponseLogin, string, error)
 	GetUserIDFromUsername(username string) (uuid.UUID, error)
 	GetUserInfo(userID uuid.UUID) (dto.ResponseGetUserInfo, error)
+	GetWallet(userID uuid.UUID) (dto.ResponseGetWallet, error)
+	GetWalletStatement(userID uuid.UUID) ([]dto.ResponseLedgerEntry, error)
+	GetPoints(userID uuid.UUID) (dto.ResponseGetPoints, error)
+	GetPointsHistory(userID uuid.UUID) ([]dto.ResponsePointsEntry, error)
 	SoftDelete(userID uuid.UUID) error
 }
 
@@ -233,6 +239,66 @@ func (u *UserUseCase) GetUserInfo(userID uuid.UUID) (dto.ResponseGetUserInfo, er
 	return user.ParseToDTOResponseGetUserInfo(), nil
 }
 
+func (u *UserUseCase) GetWallet(userID uuid.UUID) (dto.ResponseGetWallet, error) {
+	wallet := entity.Wallet{
+		UserID: userID,
+	}
+
+	err := u.userRepo.GetWallet(&wallet)
+	if err == gorm.ErrRecordNotFound {
+		return wallet.ParseToDTOResponseGetWallet(), nil
+	}
+
+	return wallet.ParseToDTOResponseGetWallet(), err
+}
+
+func (u *UserUseCase) GetWalletStatement(userID uuid.UUID) ([]dto.ResponseLedgerEntry, error) {
+	entry := new([]entity.LedgerEntry)
+
+	err := u.userRepo.GetLedgerEntryList(entry, ledger.WalletAccount(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	parsedEntry := make([]dto.ResponseLedgerEntry, len(*entry))
+
+	for i, e := range *entry {
+		parsedEntry[i] = e.ParseToDTOResponseLedgerEntry()
+	}
+
+	return parsedEntry, err
+}
+
+func (u *UserUseCase) GetPoints(userID uuid.UUID) (dto.ResponseGetPoints, error) {
+	account := entity.LoyaltyAccount{
+		UserID: userID,
+	}
+
+	err := u.userRepo.GetLoyaltyAccount(&account)
+	if err == gorm.ErrRecordNotFound {
+		return account.ParseToDTOResponseGetPoints(), nil
+	}
+
+	return account.ParseToDTOResponseGetPoints(), err
+}
+
+func (u *UserUseCase) GetPointsHistory(userID uuid.UUID) ([]dto.ResponsePointsEntry, error) {
+	entry := new([]entity.LoyaltyEntry)
+
+	err := u.userRepo.GetLoyaltyEntryList(entry, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedEntry := make([]dto.ResponsePointsEntry, len(*entry))
+
+	for i, e := range *entry {
+		parsedEntry[i] = e.ParseToDTOResponsePointsEntry()
+	}
+
+	return parsedEntry, err
+}
+
 func (u *UserUseCase) SoftDelete(userID uuid.UUID) error {
 	user := entity.User{
 		ID: userID,
@@ -17,6 +17,8 @@ import (
 	"github.com/SyafaHadyan/freepass-2026/internal/infra/jwt"
 	"github.com/SyafaHadyan/freepass-2026/internal/infra/payment"
 	"github.com/SyafaHadyan/freepass-2026/internal/infra/redis"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/scheduler"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/storage"
 	"github.com/SyafaHadyan/freepass-2026/internal/middleware"
 	"github.com/go-playground/validator/v10"
 	"gorm.io/gorm"
@@ -29,6 +31,7 @@ type Bootstrap struct {
 	Database  *gorm.DB
 	Redis     *redis.Redis
 	JWT       *jwt.JWT
+	Scheduler *scheduler.Scheduler
 }
 
 func Start() *Bootstrap {
@@ -45,21 +48,63 @@ func Start() *Bootstrap {
 
 	jwt := jwt.New(config)
 
-	payment := payment.New(config)
-
 	app := fiberapp.New(config)
 
+	payment := payment.New(config, app.Router)
+
+	storage := storage.New(config, app.Router)
+
+	scheduler := scheduler.New()
+
 	middleware := middleware.NewMiddleware(*jwt)
 
 	userRepository := userrepository.NewUserDB(database)
 	canteenRepository := canteenrepository.NewCanteenDB(database)
 
 	userUseCase := userusecase.NewUserUseCase(userRepository, jwt, redis)
-	canteenUseCase := canteenusecase.NewCanteenUseCase(canteenRepository, payment, config, redis)
+	canteenUseCase := canteenusecase.NewCanteenUseCase(canteenRepository, payment, storage, config, redis)
 
 	userhandler.NewUserHandler(app.Router, validator, middleware, userUseCase, config)
 	canteenhandler.NewCanteenHandler(app.Router, validator, middleware, canteenUseCase, config)
 
+	scheduler.Add(
+		"order expiry",
+		time.Duration(config.OrderExpiryIntervalSeconds)*time.Second,
+		canteenUseCase.ExpireOrders,
+	)
+
+	scheduler.Add(
+		"payment reconciliation",
+		time.Duration(config.ReconciliationIntervalMinutes)*time.Minute,
+		canteenUseCase.ReconcilePayments,
+	)
+
+	scheduler.Add(
+		"payout settlement",
+		time.Duration(config.PayoutIntervalMinutes)*time.Minute,
+		canteenUseCase.SettlePayouts,
+	)
+
+	scheduler.Add(
+		"loyalty points expiry",
+		time.Duration(config.LoyaltyExpiryIntervalMinutes)*time.Minute,
+		canteenUseCase.ExpirePoints,
+	)
+
+	scheduler.Add(
+		"stock reset",
+		time.Duration(config.StockResetIntervalMinutes)*time.Minute,
+		canteenUseCase.ResetStock,
+	)
+
+	scheduler.Add(
+		"refund retry",
+		time.Duration(config.RefundRetryIntervalMinutes)*time.Minute,
+		canteenUseCase.RetryRefunds,
+	)
+
+	scheduler.Start()
+
 	Bootstrap := Bootstrap{
 		App:       app,
 		Config:    config,
@@ -67,6 +112,7 @@ func Start() *Bootstrap {
 		Database:  database,
 		Redis:     redis,
 		JWT:       jwt,
+		Scheduler: scheduler,
 	}
 
 	log.Printf("startup time: %v", time.Since(startTime))
@@ -0,0 +1,9 @@
+// Package discount defines how a voucher discounts an order
+package discount
+
+type Type string
+
+const (
+	Percentage Type = "PERCENTAGE"
+	Fixed      Type = "FIXED"
+)
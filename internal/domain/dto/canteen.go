@@ -8,16 +8,58 @@ import (
 )
 
 type CreateCanteen struct {
-	UserID uuid.UUID `json:"user_id"`
-	Name   string    `json:"name" validate:"required,min=3,max=64"`
+	UserID           uuid.UUID `json:"user_id"`
+	Name             string    `json:"name" validate:"required,min=3,max=64"`
+	AllowCashPayment bool      `json:"allow_cash_payment"`
+	ServiceFeeRate   uint32    `json:"service_fee_rate" validate:"omitempty,max=10000"`
+	TaxRate          uint32    `json:"tax_rate" validate:"omitempty,max=10000"`
+	RoundingUnit     uint32    `json:"rounding_unit" validate:"omitempty,max=100000"`
+	LoyaltyRate      uint32    `json:"loyalty_rate" validate:"omitempty,max=10000"`
+	Timezone         string    `json:"timezone" validate:"omitempty,timezone"`
+	StockResetTime   string    `json:"stock_reset_time" validate:"omitempty,datetime=15:04"`
+}
+
+type UpdateCanteen struct {
+	ID               uuid.UUID `json:"id"`
+	UserID           uuid.UUID `json:"user_id"`
+	Name             string    `json:"name" validate:"required,min=3,max=64"`
+	AllowCashPayment bool      `json:"allow_cash_payment"`
+	ServiceFeeRate   uint32    `json:"service_fee_rate" validate:"omitempty,max=10000"`
+	TaxRate          uint32    `json:"tax_rate" validate:"omitempty,max=10000"`
+	RoundingUnit     uint32    `json:"rounding_unit" validate:"omitempty,max=100000"`
+	LoyaltyRate      uint32    `json:"loyalty_rate" validate:"omitempty,max=10000"`
+	Timezone         string    `json:"timezone" validate:"omitempty,timezone"`
+	StockResetTime   string    `json:"stock_reset_time" validate:"omitempty,datetime=15:04"`
 }
 
 type ResponseCreateCanteen struct {
-	ID        uuid.UUID `json:"id"`
-	UserID    uuid.UUID `json:"user_id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               uuid.UUID `json:"id"`
+	UserID           uuid.UUID `json:"user_id"`
+	Name             string    `json:"name"`
+	AllowCashPayment bool      `json:"allow_cash_payment"`
+	ServiceFeeRate   uint32    `json:"service_fee_rate"`
+	TaxRate          uint32    `json:"tax_rate"`
+	RoundingUnit     uint32    `json:"rounding_unit"`
+	LoyaltyRate      uint32    `json:"loyalty_rate"`
+	Timezone         string    `json:"timezone"`
+	StockResetTime   string    `json:"stock_reset_time"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type ResponseUpdateCanteen struct {
+	ID               uuid.UUID `json:"id"`
+	UserID           uuid.UUID `json:"user_id"`
+	Name             string    `json:"name"`
+	AllowCashPayment bool      `json:"allow_cash_payment"`
+	ServiceFeeRate   uint32    `json:"service_fee_rate"`
+	TaxRate          uint32    `json:"tax_rate"`
+	RoundingUnit     uint32    `json:"rounding_unit"`
+	LoyaltyRate      uint32    `json:"loyalty_rate"`
+	Timezone         string    `json:"timezone"`
+	StockResetTime   string    `json:"stock_reset_time"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
 
 type ResponseGetCanteenList struct {
@@ -26,9 +68,16 @@ type ResponseGetCanteenList struct {
 }
 
 type ResponseGetCanteenInfo struct {
-	ID        uuid.UUID `json:"id"`
-	UserID    uuid.UUID `json:"user_id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               uuid.UUID `json:"id"`
+	UserID           uuid.UUID `json:"user_id"`
+	Name             string    `json:"name"`
+	AllowCashPayment bool      `json:"allow_cash_payment"`
+	ServiceFeeRate   uint32    `json:"service_fee_rate"`
+	TaxRate          uint32    `json:"tax_rate"`
+	RoundingUnit     uint32    `json:"rounding_unit"`
+	LoyaltyRate      uint32    `json:"loyalty_rate"`
+	Timezone         string    `json:"timezone"`
+	StockResetTime   string    `json:"stock_reset_time"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
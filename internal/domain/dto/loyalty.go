@@ -0,0 +1,22 @@
+// Package dto defines standarized struct to be used as data exchange
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ResponseGetPoints struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Balance   uint32    `json:"balance"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ResponsePointsEntry struct {
+	ID        uuid.UUID `json:"id"`
+	OrderID   uuid.UUID `json:"order_id"`
+	Kind      string    `json:"kind"`
+	Points    int32     `json:"points"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -8,51 +8,205 @@ import (
 )
 
 type CreateMenu struct {
-	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id" validate:"required,uuid_rfc4122"`
-	Name      string    `json:"name" validate:"required,min=3,max=64"`
-	Price     uint32    `json:"price" validate:"required,number,min=1"`
-	Stock     uint32    `json:"stock" validate:"required,number,min=1"`
+	ID         uuid.UUID          `json:"id" form:"id"`
+	CanteenID  uuid.UUID          `json:"canteen_id" form:"canteen_id" validate:"required,uuid_rfc4122"`
+	CategoryID uuid.UUID          `json:"category_id" form:"category_id"`
+	Name       string             `json:"name" form:"name" validate:"required,min=3,max=64"`
+	Type       string             `json:"type" form:"type" validate:"omitempty,oneof=SINGLE BUNDLE"`
+	Position   uint32             `json:"position" form:"position" validate:"omitempty,number"`
+	Price      uint32             `json:"price" form:"price" validate:"required,number,min=1"`
+	Stock      uint32             `json:"stock" form:"stock" validate:"required_unless=Type BUNDLE,omitempty,number,min=1"`
+	ParLevel   uint32             `json:"par_level" form:"par_level" validate:"omitempty,number"`
+	Image      []byte             `json:"-" form:"-"`
+	Components []CreateBundleItem `json:"components" form:"-" validate:"required_if=Type BUNDLE,omitempty,max=16,dive"`
+}
+
+type CreateBundleItem struct {
+	MenuID   uuid.UUID `json:"menu_id" validate:"required,uuid_rfc4122"`
+	Quantity uint32    `json:"quantity" validate:"required,number,min=1"`
 }
 
 type ResponseCreateMenu struct {
-	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id"`
-	Name      string    `json:"name"`
-	Price     uint32    `json:"price"`
-	Stock     uint32    `json:"stock"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         uuid.UUID `json:"id"`
+	CanteenID  uuid.UUID `json:"canteen_id"`
+	CategoryID uuid.UUID `json:"category_id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	Position   uint32    `json:"position"`
+	Price      uint32    `json:"price"`
+	Stock      uint32    `json:"stock"`
+	ParLevel   uint32    `json:"par_level"`
+	Image      string    `json:"image_url"`
+	Thumbnail  string    `json:"thumbnail_url"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
 }
 
 type UpdateMenu struct {
-	ID     uuid.UUID `json:"id" validate:"required,required,uuid_rfc4122"`
-	UserID uuid.UUID `json:"user_id" validate:"required,required,uuid_rfc4122"`
-	Name   string    `json:"name" validate:"omitempty,min=3,max=64"`
-	Price  uint32    `json:"price" validate:"omitempty,number,min=1"`
-	Stock  uint32    `json:"stock" validate:"omitempty,number,min=1"`
+	ID         uuid.UUID `json:"id" form:"id" validate:"required,required,uuid_rfc4122"`
+	UserID     uuid.UUID `json:"user_id" form:"user_id" validate:"required,required,uuid_rfc4122"`
+	CategoryID uuid.UUID `json:"category_id" form:"category_id"`
+	Name       string    `json:"name" form:"name" validate:"omitempty,min=3,max=64"`
+	Position   uint32    `json:"position" form:"position" validate:"omitempty,number"`
+	Price      uint32    `json:"price" form:"price" validate:"omitempty,number,min=1"`
+	Stock      uint32    `json:"stock" form:"stock" validate:"omitempty,number,min=1"`
+	ParLevel   uint32    `json:"par_level" form:"par_level" validate:"omitempty,number"`
+	Image      []byte    `json:"-" form:"-"`
 }
 
 type ResponseUpdateMenu struct {
+	ID         uuid.UUID `json:"id"`
+	CanteenID  uuid.UUID `json:"canteen_id"`
+	CategoryID uuid.UUID `json:"category_id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	Position   uint32    `json:"position"`
+	Price      uint32    `json:"price"`
+	Stock      uint32    `json:"stock"`
+	ParLevel   uint32    `json:"par_level"`
+	Image      string    `json:"image_url"`
+	Thumbnail  string    `json:"thumbnail_url"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ResponseGetMenuInfo struct {
+	ID              uuid.UUID               `json:"id"`
+	CanteenID       uuid.UUID               `json:"canteen_id"`
+	CategoryID      uuid.UUID               `json:"category_id"`
+	Name            string                  `json:"name"`
+	Type            string                  `json:"type"`
+	Position        uint32                  `json:"position"`
+	Price           uint32                  `json:"price"`
+	DiscountedPrice uint32                  `json:"discounted_price"`
+	Promotion       *ResponsePromotion      `json:"promotion,omitempty"`
+	Modifiers       []ResponseModifierGroup `json:"modifiers,omitempty"`
+	Components      []ResponseBundleItem    `json:"components,omitempty"`
+	Available       bool                    `json:"available"`
+	Stock           uint32                  `json:"stock"`
+	ParLevel        uint32                  `json:"par_level"`
+	Image           string                  `json:"image_url"`
+	Thumbnail       string                  `json:"thumbnail_url"`
+	CreatedAt       time.Time               `json:"created_at"`
+	UpdatedAt       time.Time               `json:"updated_at"`
+}
+
+type GetMenuList struct {
+	CanteenID uuid.UUID `query:"-" validate:"required,uuid_rfc4122"`
+	Page      int       `query:"page" validate:"omitempty,min=1"`
+	Limit     int       `query:"limit" validate:"omitempty,min=1,max=100"`
+	Sort      string    `query:"sort" validate:"omitempty,oneof=price name popularity"`
+	Order     string    `query:"order" validate:"omitempty,oneof=asc desc"`
+	InStock   bool      `query:"in_stock"`
+	Available bool      `query:"available"`
+	MinPrice  uint32    `query:"min_price" validate:"omitempty,number"`
+	MaxPrice  uint32    `query:"max_price" validate:"omitempty,number,gtefield=MinPrice"`
+}
+
+type ResponseGetMenuList struct {
+	Menus []ResponseGetMenuInfo `json:"menus"`
+	Page  int                   `json:"page"`
+	Limit int                   `json:"limit"`
+	Total int64                 `json:"total"`
+}
+
+type ResponseBundleItem struct {
+	MenuID   uuid.UUID `json:"menu_id"`
+	Name     string    `json:"name"`
+	Quantity uint32    `json:"quantity"`
+}
+
+type RestockMenu struct {
+	UserID uuid.UUID         `json:"user_id"`
+	Items  []RestockMenuItem `json:"items" validate:"required,min=1,max=100,dive"`
+}
+
+type RestockMenuItem struct {
+	MenuID   uuid.UUID `json:"menu_id" validate:"required,uuid_rfc4122"`
+	Stock    uint32    `json:"stock" validate:"omitempty,number"`
+	ParLevel *uint32   `json:"par_level" validate:"omitempty,number"`
+}
+
+type SoftDeleteMenu struct {
+	ID uuid.UUID `json:"id" validate:"required,required,uuid_rfc4122"`
+}
+
+type CreatePromotion struct {
+	MenuID     uuid.UUID  `json:"menu_id" validate:"required,uuid_rfc4122"`
+	Name       string     `json:"name" validate:"required,min=3,max=64"`
+	Type       string     `json:"type" validate:"required,oneof=PERCENTAGE FIXED"`
+	Value      uint32     `json:"value" validate:"required,number,min=1"`
+	Weekdays   []int      `json:"weekdays" validate:"omitempty,max=7,dive,min=0,max=6"`
+	StartTime  string     `json:"start_time" validate:"omitempty,datetime=15:04,required_with=EndTime"`
+	EndTime    string     `json:"end_time" validate:"omitempty,datetime=15:04,required_with=StartTime"`
+	ValidFrom  time.Time  `json:"valid_from" validate:"required"`
+	ValidUntil *time.Time `json:"valid_until" validate:"omitempty,gtfield=ValidFrom"`
+}
+
+type ResponsePromotion struct {
+	ID         uuid.UUID  `json:"id"`
+	MenuID     uuid.UUID  `json:"menu_id"`
+	Name       string     `json:"name"`
+	Type       string     `json:"type"`
+	Value      uint32     `json:"value"`
+	Weekdays   []int      `json:"weekdays"`
+	StartTime  string     `json:"start_time"`
+	EndTime    string     `json:"end_time"`
+	ValidFrom  time.Time  `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreateMenuCategory struct {
+	CanteenID uuid.UUID `json:"canteen_id" validate:"required,uuid_rfc4122"`
+	Name      string    `json:"name" validate:"required,min=3,max=64"`
+	Position  uint32    `json:"position" validate:"omitempty,number"`
+}
+
+type UpdateMenuCategory struct {
+	ID       uuid.UUID `json:"id" validate:"required,uuid_rfc4122"`
+	UserID   uuid.UUID `json:"user_id" validate:"required,uuid_rfc4122"`
+	Name     string    `json:"name" validate:"omitempty,min=3,max=64"`
+	Position uint32    `json:"position" validate:"omitempty,number"`
+}
+
+type ResponseMenuCategory struct {
 	ID        uuid.UUID `json:"id"`
 	CanteenID uuid.UUID `json:"canteen_id"`
 	Name      string    `json:"name"`
-	Price     uint32    `json:"price"`
-	Stock     uint32    `json:"stock"`
+	Position  uint32    `json:"position"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
-type ResponseGetMenuInfo struct {
+type CreateMenuAvailability struct {
+	MenuID    uuid.UUID `json:"menu_id" validate:"required,uuid_rfc4122"`
+	Weekdays  []int     `json:"weekdays" validate:"omitempty,max=7,dive,min=0,max=6"`
+	StartTime string    `json:"start_time" validate:"omitempty,datetime=15:04,required_with=EndTime"`
+	EndTime   string    `json:"end_time" validate:"omitempty,datetime=15:04,required_with=StartTime"`
+	StartDate string    `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate   string    `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type ResponseMenuAvailability struct {
 	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id"`
-	Name      string    `json:"name"`
-	Price     uint32    `json:"price"`
-	Stock     uint32    `json:"stock"`
+	MenuID    uuid.UUID `json:"menu_id"`
+	Weekdays  []int     `json:"weekdays"`
+	StartTime string    `json:"start_time"`
+	EndTime   string    `json:"end_time"`
+	StartDate string    `json:"start_date"`
+	EndDate   string    `json:"end_date"`
 	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
 }
 
-type SoftDeleteMenu struct {
-	ID uuid.UUID `json:"id" validate:"required,required,uuid_rfc4122"`
+type ResponseMenuGroup struct {
+	CategoryID uuid.UUID             `json:"category_id"`
+	Name       string                `json:"name"`
+	Position   uint32                `json:"position"`
+	Menus      []ResponseGetMenuInfo `json:"menus"`
+}
+
+type ResponseGetCanteenMenu struct {
+	CanteenID uuid.UUID           `json:"canteen_id"`
+	Groups    []ResponseMenuGroup `json:"groups"`
 }
@@ -0,0 +1,59 @@
+// Package dto defines standarized struct to be used as data exchange
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type CreateModifierGroup struct {
+	MenuID      uuid.UUID              `json:"menu_id" validate:"required,uuid_rfc4122"`
+	Name        string                 `json:"name" validate:"required,min=3,max=64"`
+	Required    bool                   `json:"required"`
+	MultiSelect bool                   `json:"multi_select"`
+	MinSelect   uint32                 `json:"min_select" validate:"omitempty,number"`
+	MaxSelect   uint32                 `json:"max_select" validate:"omitempty,number"`
+	Position    uint32                 `json:"position" validate:"omitempty,number"`
+	Options     []CreateModifierOption `json:"options" validate:"required,min=1,max=32,dive"`
+}
+
+type CreateModifierOption struct {
+	Name       string  `json:"name" validate:"required,min=1,max=64"`
+	PriceDelta int32   `json:"price_delta"`
+	Stock      *uint32 `json:"stock" validate:"omitempty,number"`
+}
+
+type UpdateModifierOption struct {
+	ID         uuid.UUID `json:"id" validate:"required,uuid_rfc4122"`
+	UserID     uuid.UUID `json:"user_id" validate:"required,uuid_rfc4122"`
+	Name       string    `json:"name" validate:"omitempty,min=1,max=64"`
+	PriceDelta int32     `json:"price_delta"`
+	Stock      *uint32   `json:"stock" validate:"omitempty,number"`
+}
+
+type ResponseModifierGroup struct {
+	ID          uuid.UUID                `json:"id"`
+	MenuID      uuid.UUID                `json:"menu_id"`
+	Name        string                   `json:"name"`
+	Required    bool                     `json:"required"`
+	MultiSelect bool                     `json:"multi_select"`
+	MinSelect   uint32                   `json:"min_select"`
+	MaxSelect   uint32                   `json:"max_select"`
+	Position    uint32                   `json:"position"`
+	Options     []ResponseModifierOption `json:"options"`
+}
+
+type ResponseModifierOption struct {
+	ID         uuid.UUID `json:"id"`
+	GroupID    uuid.UUID `json:"group_id"`
+	Name       string    `json:"name"`
+	PriceDelta int32     `json:"price_delta"`
+	Stock      *uint32   `json:"stock"`
+	Position   uint32    `json:"position"`
+}
+
+type ResponseOrderItemOption struct {
+	OptionID   uuid.UUID `json:"option_id"`
+	GroupName  string    `json:"group_name"`
+	Name       string    `json:"name"`
+	PriceDelta int32     `json:"price_delta"`
+}
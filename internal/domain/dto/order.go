@@ -4,74 +4,161 @@ package dto
 import (
 	"time"
 
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/orderstatus"
 	"github.com/google/uuid"
 )
 
 type CreateOrder struct {
-	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id" validate:"required,uuid_rfc4122"`
-	UserID    uuid.UUID `json:"user_id" validate:"required,uuid_rfc4122"`
-	MenuID    uuid.UUID `json:"menu_id" validate:"required,uuid_rfc4122"`
-	Quantity  uint32    `json:"quantity" validate:"required,number,min=1"`
-	Status    string    `json:"status"`
+	ID          uuid.UUID          `json:"id"`
+	CanteenID   uuid.UUID          `json:"canteen_id" validate:"required,uuid_rfc4122"`
+	UserID      uuid.UUID          `json:"user_id" validate:"required,uuid_rfc4122"`
+	Items       []CreateOrderItem  `json:"items" validate:"required,min=1,max=64,dive"`
+	VoucherCode string             `json:"voucher_code" validate:"omitempty,alphanum,max=32"`
+	Status      orderstatus.Status `json:"status"`
+}
+
+type CreateOrderItem struct {
+	MenuID   uuid.UUID   `json:"menu_id" validate:"required,uuid_rfc4122"`
+	Quantity uint32      `json:"quantity" validate:"required,number,min=1"`
+	Options  []uuid.UUID `json:"options" validate:"omitempty,max=32"`
+}
+
+type ResponseOrderItem struct {
+	ID            uuid.UUID                 `json:"id"`
+	MenuID        uuid.UUID                 `json:"menu_id"`
+	Name          string                    `json:"name"`
+	Quantity      uint32                    `json:"quantity"`
+	Price         uint32                    `json:"price"`
+	OriginalPrice uint32                    `json:"original_price"`
+	Options       []ResponseOrderItemOption `json:"options"`
+}
+
+type ResponseOrderStatusHistory struct {
+	FromStatus orderstatus.Status `json:"from_status"`
+	ToStatus   orderstatus.Status `json:"to_status"`
+	ChangedBy  uuid.UUID          `json:"changed_by"`
+	CreatedAt  time.Time          `json:"created_at"`
 }
 
 type ResponseCreateOrder struct {
-	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id"`
-	UserID    uuid.UUID `json:"user_id"`
-	MenuID    uuid.UUID `json:"menu_id"`
-	Quantity  uint32    `json:"quantity"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          uuid.UUID           `json:"id"`
+	CanteenID   uuid.UUID           `json:"canteen_id"`
+	UserID      uuid.UUID           `json:"user_id"`
+	Subtotal    uint32              `json:"subtotal"`
+	VoucherCode string              `json:"voucher_code,omitempty"`
+	Discount    uint32              `json:"discount"`
+	PointsUsed  uint32              `json:"points_used"`
+	ServiceFee  uint32              `json:"service_fee"`
+	Tax         uint32              `json:"tax"`
+	Rounding    int32               `json:"rounding"`
+	Total       uint32              `json:"total"`
+	Status      orderstatus.Status  `json:"status"`
+	Items       []ResponseOrderItem `json:"items"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
 }
 
 type UpdateOrder struct {
-	ID     uuid.UUID `json:"id" validate:"required,uuid_rfc4122"`
-	Status string    `json:"status" validate:"required,oneof=COOKING COMPLETED"`
+	ID     uuid.UUID          `json:"id" validate:"required,uuid_rfc4122"`
+	Status orderstatus.Status `json:"status" validate:"required,oneof=WAITING COOKING READY COMPLETED"`
 }
 
 type ResponseUpdateOrder struct {
-	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id"`
-	UserID    uuid.UUID `json:"user_id"`
-	MenuID    uuid.UUID `json:"menu_id"`
-	Quantity  uint32    `json:"quantity"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        uuid.UUID           `json:"id"`
+	CanteenID uuid.UUID           `json:"canteen_id"`
+	UserID    uuid.UUID           `json:"user_id"`
+	Total     uint32              `json:"total"`
+	Status    orderstatus.Status  `json:"status"`
+	Reason    string              `json:"reason,omitempty"`
+	Items     []ResponseOrderItem `json:"items"`
+	Refund    *ResponseGetRefund  `json:"refund,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+type CancelOrder struct {
+	ID     uuid.UUID `json:"id" validate:"required,uuid_rfc4122"`
+	UserID uuid.UUID `json:"user_id" validate:"required,uuid_rfc4122"`
+}
+
+type RejectOrder struct {
+	ID     uuid.UUID `json:"id" validate:"required,uuid_rfc4122"`
+	Reason string    `json:"reason" validate:"required,min=3,max=256"`
 }
 
 type GetOrderInfo struct {
-	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id"`
-	UserID    uuid.UUID `json:"user_id"`
-	MenuID    uuid.UUID `json:"menu_id"`
-	Quantity  uint32    `json:"quantity"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        uuid.UUID          `json:"id"`
+	CanteenID uuid.UUID          `json:"canteen_id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Status    orderstatus.Status `json:"status"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
 }
 
 type ResponseGetOrderInfo struct {
-	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id"`
-	UserID    uuid.UUID `json:"user_id"`
-	MenuID    uuid.UUID `json:"menu_id"`
-	Quantity  uint32    `json:"quantity"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            uuid.UUID                    `json:"id"`
+	CanteenID     uuid.UUID                    `json:"canteen_id"`
+	UserID        uuid.UUID                    `json:"user_id"`
+	Subtotal      uint32                       `json:"subtotal"`
+	VoucherCode   string                       `json:"voucher_code,omitempty"`
+	Discount      uint32                       `json:"discount"`
+	PointsUsed    uint32                       `json:"points_used"`
+	ServiceFee    uint32                       `json:"service_fee"`
+	Tax           uint32                       `json:"tax"`
+	Rounding      int32                        `json:"rounding"`
+	Total         uint32                       `json:"total"`
+	Status        orderstatus.Status           `json:"status"`
+	Reason        string                       `json:"reason,omitempty"`
+	ReceiptNumber string                       `json:"receipt_number,omitempty"`
+	PaidAt        *time.Time                   `json:"paid_at,omitempty"`
+	Items         []ResponseOrderItem          `json:"items"`
+	Histories     []ResponseOrderStatusHistory `json:"histories"`
+	CreatedAt     time.Time                    `json:"created_at"`
+	UpdatedAt     time.Time                    `json:"updated_at"`
 }
 
 type ResponseGetOrderList struct {
-	ID        uuid.UUID `json:"id"`
-	CanteenID uuid.UUID `json:"canteen_id"`
-	UserID    uuid.UUID `json:"user_id"`
-	MenuID    uuid.UUID `json:"menu_id"`
-	Quantity  uint32    `json:"quantity"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          uuid.UUID           `json:"id"`
+	CanteenID   uuid.UUID           `json:"canteen_id"`
+	UserID      uuid.UUID           `json:"user_id"`
+	Subtotal    uint32              `json:"subtotal"`
+	VoucherCode string              `json:"voucher_code,omitempty"`
+	Discount    uint32              `json:"discount"`
+	PointsUsed  uint32              `json:"points_used"`
+	ServiceFee  uint32              `json:"service_fee"`
+	Tax         uint32              `json:"tax"`
+	Rounding    int32               `json:"rounding"`
+	Total       uint32              `json:"total"`
+	Status      orderstatus.Status  `json:"status"`
+	Items       []ResponseOrderItem `json:"items"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+type ResponseGetReceipt struct {
+	ReceiptNumber string                `json:"receipt_number"`
+	OrderID       uuid.UUID             `json:"order_id"`
+	CanteenID     uuid.UUID             `json:"canteen_id"`
+	CanteenName   string                `json:"canteen_name"`
+	Items         []ResponseReceiptItem `json:"items"`
+	Subtotal      uint32                `json:"subtotal"`
+	VoucherCode   string                `json:"voucher_code,omitempty"`
+	Discount      uint32                `json:"discount"`
+	PointsUsed    uint32                `json:"points_used"`
+	ServiceFee    uint32                `json:"service_fee"`
+	Tax           uint32                `json:"tax"`
+	Rounding      int32                 `json:"rounding"`
+	Total         uint32                `json:"total"`
+	PaymentMethod string                `json:"payment_method"`
+	Status        orderstatus.Status    `json:"status"`
+	OrderedAt     time.Time             `json:"ordered_at"`
+	PaidAt        *time.Time            `json:"paid_at"`
+}
+
+type ResponseReceiptItem struct {
+	Name      string   `json:"name"`
+	Quantity  uint32   `json:"quantity"`
+	UnitPrice uint32   `json:"unit_price"`
+	Amount    uint32   `json:"amount"`
+	Options   []string `json:"options,omitempty"`
 }
@@ -4,24 +4,38 @@ package dto
 import (
 	"time"
 
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentmethod"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentstatus"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type CreatePayment struct {
-	ID          uuid.UUID      `json:"id"`
-	OrderID     uuid.UUID      `json:"order_id" validate:"required,uuid_rfc4122"`
-	UserID      uuid.UUID      `json:"user_id"`
-	Price       uint32         `json:"price"`
-	RedirectURL string         `json:"redirect_url"`
-	CreatedAt   time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
-	UpdatedAt   time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID          uuid.UUID             `json:"id"`
+	OrderID     uuid.UUID             `json:"order_id" validate:"required,uuid_rfc4122"`
+	UserID      uuid.UUID             `json:"user_id"`
+	Price       uint32                `json:"price"`
+	Method      paymentmethod.Method  `json:"method" validate:"omitempty,oneof=GATEWAY WALLET CASH"`
+	VoucherCode string                `json:"voucher_code" validate:"omitempty,alphanum,max=32"`
+	UsePoints   uint32                `json:"use_points" validate:"omitempty,number"`
+	Purpose     paymentmethod.Purpose `json:"-"`
+	RedirectURL string                `json:"redirect_url"`
+	CreatedAt   time.Time             `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt   time.Time             `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt        `gorm:"index"`
 }
 
 type CreateMidtransOrder struct {
 	TransactionDetails TransactionDetails
 	CustomerDetail     CustomerDetail
+	ItemDetails        []ItemDetail
+}
+
+type ItemDetail struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Price    int64  `json:"price"`
+	Quantity int32  `json:"quantity"`
 }
 
 type TransactionDetails struct {
@@ -36,14 +50,60 @@ type CustomerDetail struct {
 }
 
 type ResponseMidtransOrder struct {
-	Token       string `json:"token"`
-	RedirectURL string `json:"redirect_url"`
+	Token       string               `json:"token"`
+	RedirectURL string               `json:"redirect_url"`
+	Status      paymentstatus.Status `json:"status"`
+}
+
+type PaymentStatus struct {
+	OrderID           string `json:"order_id"`
+	TransactionID     string `json:"transaction_id"`
+	TransactionStatus string `json:"transaction_status"`
+	GrossAmount       string `json:"gross_amount"`
 }
 
 type VerifyPayment struct {
+	OrderID           string `json:"order_id"`
 	TransactionStatus string `json:"transaction_status"`
 	TransactionID     string `json:"transaction_id"`
 	SignatureKey      string `json:"signature_key"`
 	StatusCode        string `json:"status_code"`
 	GrossAmount       string `json:"gross_amount"`
 }
+
+type RefundPayment struct {
+	OrderID   string `json:"order_id"`
+	RefundKey string `json:"refund_key"`
+	Amount    uint32 `json:"amount"`
+	Reason    string `json:"reason"`
+}
+
+type ResponseGetPaymentNotification struct {
+	ID                uuid.UUID `json:"id"`
+	OrderID           string    `json:"order_id"`
+	TransactionID     string    `json:"transaction_id"`
+	TransactionStatus string    `json:"transaction_status"`
+	StatusCode        string    `json:"status_code"`
+	GrossAmount       string    `json:"gross_amount"`
+	Processed         bool      `json:"processed"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type CreateRefund struct {
+	OrderID uuid.UUID `json:"order_id" validate:"required,uuid_rfc4122"`
+	Amount  uint32    `json:"amount" validate:"omitempty,number,min=1"`
+	Reason  string    `json:"reason" validate:"required,min=3,max=256"`
+}
+
+type ResponseGetRefund struct {
+	ID          uuid.UUID `json:"id"`
+	PaymentID   uuid.UUID `json:"payment_id"`
+	OrderID     uuid.UUID `json:"order_id"`
+	Amount      uint32    `json:"amount"`
+	Reason      string    `json:"reason"`
+	Status      string    `json:"status"`
+	Attempts    uint32    `json:"attempts"`
+	RequestedBy uuid.UUID `json:"requested_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
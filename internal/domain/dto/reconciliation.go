@@ -0,0 +1,27 @@
+// Package dto defines standarized struct to be used as data exchange
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ResponseGetReconciliationReport struct {
+	ID         uuid.UUID                     `json:"id"`
+	Matched    uint32                        `json:"matched"`
+	Fixed      uint32                        `json:"fixed"`
+	Mismatched uint32                        `json:"mismatched"`
+	Failed     uint32                        `json:"failed"`
+	Entries    []ResponseReconciliationEntry `json:"entries,omitempty"`
+	CreatedAt  time.Time                     `json:"created_at"`
+}
+
+type ResponseReconciliationEntry struct {
+	PaymentID      uuid.UUID `json:"payment_id"`
+	LocalStatus    string    `json:"local_status"`
+	ProviderStatus string    `json:"provider_status"`
+	LocalAmount    uint32    `json:"local_amount"`
+	ProviderAmount uint32    `json:"provider_amount"`
+	Result         string    `json:"result"`
+}
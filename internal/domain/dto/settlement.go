@@ -0,0 +1,52 @@
+// Package dto defines standarized struct to be used as data exchange
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreatePayout struct {
+	Period string `json:"period" validate:"required,oneof=DAILY WEEKLY"`
+}
+
+type ResponseGetPayout struct {
+	ID               uuid.UUID                  `json:"id"`
+	CanteenID        uuid.UUID                  `json:"canteen_id"`
+	Period           string                     `json:"period"`
+	PeriodStart      time.Time                  `json:"period_start"`
+	PeriodEnd        time.Time                  `json:"period_end"`
+	PaymentCount     uint32                     `json:"payment_count"`
+	GrossAmount      uint32                     `json:"gross_amount"`
+	RefundedAmount   uint32                     `json:"refunded_amount"`
+	CashAmount       uint32                     `json:"cash_amount"`
+	CommissionRate   uint32                     `json:"commission_rate"`
+	Commission       int64                      `json:"commission"`
+	AdjustmentAmount int64                      `json:"adjustment_amount"`
+	NetAmount        int64                      `json:"net_amount"`
+	Status           string                     `json:"status"`
+	PaidBy           uuid.UUID                  `json:"paid_by"`
+	PaidAt           *time.Time                 `json:"paid_at"`
+	Items            []ResponsePayoutItem       `json:"items,omitempty"`
+	Adjustments      []ResponsePayoutAdjustment `json:"adjustments,omitempty"`
+	CreatedAt        time.Time                  `json:"created_at"`
+}
+
+type ResponsePayoutItem struct {
+	PaymentID      uuid.UUID `json:"payment_id"`
+	OrderID        uuid.UUID `json:"order_id"`
+	Method         string    `json:"method"`
+	Amount         uint32    `json:"amount"`
+	RefundedAmount uint32    `json:"refunded_amount"`
+	PaymentDate    time.Time `json:"payment_date"`
+}
+
+type ResponsePayoutAdjustment struct {
+	RefundID   uuid.UUID `json:"refund_id"`
+	PaymentID  uuid.UUID `json:"payment_id"`
+	OrderID    uuid.UUID `json:"order_id"`
+	Method     string    `json:"method"`
+	Amount     int64     `json:"amount"`
+	RefundDate time.Time `json:"refund_date"`
+}
@@ -0,0 +1,41 @@
+// Package dto defines standarized struct to be used as data exchange
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateVoucher struct {
+	Code         string    `json:"code" validate:"required,alphanum,min=3,max=32"`
+	CanteenID    uuid.UUID `json:"canteen_id"`
+	MenuID       uuid.UUID `json:"menu_id"`
+	Type         string    `json:"type" validate:"required,oneof=PERCENTAGE FIXED"`
+	Value        uint32    `json:"value" validate:"required,number,min=1"`
+	MinSpend     uint32    `json:"min_spend" validate:"omitempty,number"`
+	MaxDiscount  uint32    `json:"max_discount" validate:"omitempty,number"`
+	UsageLimit   uint32    `json:"usage_limit" validate:"omitempty,number"`
+	PerUserLimit uint32    `json:"per_user_limit" validate:"omitempty,number"`
+	ValidFrom    time.Time `json:"valid_from" validate:"required"`
+	ValidUntil   time.Time `json:"valid_until" validate:"required,gtfield=ValidFrom"`
+}
+
+type ResponseGetVoucher struct {
+	ID           uuid.UUID `json:"id"`
+	Code         string    `json:"code"`
+	CreatedBy    uuid.UUID `json:"created_by"`
+	CanteenID    uuid.UUID `json:"canteen_id"`
+	MenuID       uuid.UUID `json:"menu_id"`
+	Type         string    `json:"type"`
+	Value        uint32    `json:"value"`
+	MinSpend     uint32    `json:"min_spend"`
+	MaxDiscount  uint32    `json:"max_discount"`
+	UsageLimit   uint32    `json:"usage_limit"`
+	PerUserLimit uint32    `json:"per_user_limit"`
+	UsedCount    uint32    `json:"used_count"`
+	ValidFrom    time.Time `json:"valid_from"`
+	ValidUntil   time.Time `json:"valid_until"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
@@ -0,0 +1,29 @@
+// Package dto defines standarized struct to be used as data exchange
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TopUpWallet struct {
+	UserID uuid.UUID `json:"user_id"`
+	Amount uint32    `json:"amount" validate:"required,number,min=1000,max=10000000"`
+}
+
+type ResponseGetWallet struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Balance   uint32    `json:"balance"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ResponseLedgerEntry struct {
+	ID            uuid.UUID `json:"id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	Direction     string    `json:"direction"`
+	Amount        uint32    `json:"amount"`
+	PaymentID     uuid.UUID `json:"payment_id"`
+	Description   string    `json:"description"`
+	CreatedAt     time.Time `json:"created_at"`
+}
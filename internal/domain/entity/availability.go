@@ -0,0 +1,69 @@
+// Package entity defines database table and its relations
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MenuAvailability struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
+	MenuID      uuid.UUID      `json:"menu_id" gorm:"type:char(36);index"`
+	Weekdays    uint8          `json:"weekdays" gorm:"type:tinyint unsigned"`
+	StartMinute uint16         `json:"start_minute" gorm:"type:smallint unsigned"`
+	EndMinute   uint16         `json:"end_minute" gorm:"type:smallint unsigned"`
+	StartDate   string         `json:"start_date" gorm:"type:varchar(10)"`
+	EndDate     string         `json:"end_date" gorm:"type:varchar(10)"`
+	CreatedAt   time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt   time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+func (a *MenuAvailability) Active(now time.Time) bool {
+	date := now.Format(time.DateOnly)
+
+	if (a.StartDate != "" && date < a.StartDate) || (a.EndDate != "" && date > a.EndDate) {
+		return false
+	}
+
+	return withinWindow(now, a.Weekdays, a.StartMinute, a.EndMinute)
+}
+
+func (m *Menu) Available(now time.Time) bool {
+	if len(m.Availabilities) == 0 {
+		return true
+	}
+
+	for i := range m.Availabilities {
+		if m.Availabilities[i].Active(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *MenuAvailability) ParseToDTOResponseMenuAvailability() dto.ResponseMenuAvailability {
+	var weekdays []int
+
+	for day := range 7 {
+		if a.Weekdays&(1<<day) != 0 {
+			weekdays = append(weekdays, day)
+		}
+	}
+
+	return dto.ResponseMenuAvailability{
+		ID:        a.ID,
+		MenuID:    a.MenuID,
+		Weekdays:  weekdays,
+		StartTime: fmt.Sprintf("%02d:%02d", a.StartMinute/60, a.StartMinute%60),
+		EndTime:   fmt.Sprintf("%02d:%02d", a.EndMinute/60, a.EndMinute%60),
+		StartDate: a.StartDate,
+		EndDate:   a.EndDate,
+		CreatedAt: a.CreatedAt,
+	}
+}
@@ -2,6 +2,8 @@
 package entity
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
@@ -10,21 +12,71 @@ import (
 )
 
 type Canteen struct {
-	ID        uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
-	UserID    uuid.UUID      `json:"user_id" gorm:"type:char(36)"`
-	Name      string         `json:"name" gorm:"type:varchar(128)"`
-	CreatedAt time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
-	UpdatedAt time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID               uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
+	UserID           uuid.UUID      `json:"user_id" gorm:"type:char(36)"`
+	Name             string         `json:"name" gorm:"type:varchar(128)"`
+	AllowCashPayment bool           `json:"allow_cash_payment" gorm:"type:boolean"`
+	ServiceFeeRate   uint32         `json:"service_fee_rate" gorm:"type:integer unsigned"`
+	TaxRate          uint32         `json:"tax_rate" gorm:"type:integer unsigned"`
+	RoundingUnit     uint32         `json:"rounding_unit" gorm:"type:integer unsigned"`
+	LoyaltyRate      uint32         `json:"loyalty_rate" gorm:"type:integer unsigned"`
+	Timezone         string         `json:"timezone" gorm:"type:varchar(64)"`
+	StockResetTime   string         `json:"stock_reset_time" gorm:"type:varchar(5)"`
+	LastStockReset   string         `json:"last_stock_reset" gorm:"type:varchar(10)"`
+	ReceiptSequence  uint32         `json:"receipt_sequence" gorm:"type:integer unsigned"`
+	CreatedAt        time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt        time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+}
+
+func (c *Canteen) Location() *time.Location {
+	if c.Timezone == "" {
+		return time.Local
+	}
+
+	location, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.Local
+	}
+
+	return location
+}
+
+func (c *Canteen) ReceiptNumber(sequence uint32) string {
+	return fmt.Sprintf("%s-%06d", strings.ToUpper(c.ID.String()[:8]), sequence)
 }
 
 func (c *Canteen) ParseToDTOResponseCreateCanteen() dto.ResponseCreateCanteen {
 	return dto.ResponseCreateCanteen{
-		ID:        c.ID,
-		UserID:    c.UserID,
-		Name:      c.Name,
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
+		ID:               c.ID,
+		UserID:           c.UserID,
+		Name:             c.Name,
+		AllowCashPayment: c.AllowCashPayment,
+		ServiceFeeRate:   c.ServiceFeeRate,
+		TaxRate:          c.TaxRate,
+		RoundingUnit:     c.RoundingUnit,
+		LoyaltyRate:      c.LoyaltyRate,
+		Timezone:         c.Timezone,
+		StockResetTime:   c.StockResetTime,
+		CreatedAt:        c.CreatedAt,
+		UpdatedAt:        c.UpdatedAt,
+	}
+}
+
+func (c *Canteen) ParseToDTOResponseUpdateCanteen() dto.ResponseUpdateCanteen {
+	return dto.ResponseUpdateCanteen{
+		ID:               c.ID,
+		UserID:           c.UserID,
+		Name:             c.Name,
+		AllowCashPayment: c.AllowCashPayment,
+		ServiceFeeRate:   c.ServiceFeeRate,
+		TaxRate:          c.TaxRate,
+		RoundingUnit:     c.RoundingUnit,
+		LoyaltyRate:      c.LoyaltyRate,
+		Timezone:         c.Timezone,
+		StockResetTime:   c.StockResetTime,
+		CreatedAt:        c.CreatedAt,
+		UpdatedAt:        c.UpdatedAt,
 	}
 }
 
@@ -37,10 +89,17 @@ func (c *Canteen) ParseToDTOResponseGetCanteenList() dto.ResponseGetCanteenList
 
 func (c *Canteen) ParseToDTOResponseGetCanteenInfo() dto.ResponseGetCanteenInfo {
 	return dto.ResponseGetCanteenInfo{
-		ID:        c.ID,
-		UserID:    c.UserID,
-		Name:      c.Name,
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
+		ID:               c.ID,
+		UserID:           c.UserID,
+		Name:             c.Name,
+		AllowCashPayment: c.AllowCashPayment,
+		ServiceFeeRate:   c.ServiceFeeRate,
+		TaxRate:          c.TaxRate,
+		RoundingUnit:     c.RoundingUnit,
+		LoyaltyRate:      c.LoyaltyRate,
+		Timezone:         c.Timezone,
+		StockResetTime:   c.StockResetTime,
+		CreatedAt:        c.CreatedAt,
+		UpdatedAt:        c.UpdatedAt,
 	}
 }
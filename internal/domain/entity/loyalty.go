@@ -0,0 +1,46 @@
+// Package entity defines database table and its relations
+package entity
+
+import (
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/loyalty"
+	"github.com/google/uuid"
+)
+
+type LoyaltyAccount struct {
+	ID        uuid.UUID `json:"id" gorm:"type:char(36);primaryKey"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:char(36);uniqueIndex"`
+	Balance   uint32    `json:"balance" gorm:"type:integer unsigned"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+}
+
+type LoyaltyEntry struct {
+	ID        uuid.UUID    `json:"id" gorm:"type:char(36);primaryKey"`
+	UserID    uuid.UUID    `json:"user_id" gorm:"type:char(36);index"`
+	OrderID   uuid.UUID    `json:"order_id" gorm:"type:char(36);index"`
+	Kind      loyalty.Kind `json:"kind" gorm:"type:varchar(16)"`
+	Points    int32        `json:"points" gorm:"type:integer"`
+	Remaining uint32       `json:"remaining" gorm:"type:integer unsigned"`
+	CreatedAt time.Time    `json:"created_at" gorm:"type:timestamp;autoCreateTime;index"`
+}
+
+func (a *LoyaltyAccount) ParseToDTOResponseGetPoints() dto.ResponseGetPoints {
+	return dto.ResponseGetPoints{
+		UserID:    a.UserID,
+		Balance:   a.Balance,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+func (l *LoyaltyEntry) ParseToDTOResponsePointsEntry() dto.ResponsePointsEntry {
+	return dto.ResponsePointsEntry{
+		ID:        l.ID,
+		OrderID:   l.OrderID,
+		Kind:      string(l.Kind),
+		Points:    l.Points,
+		CreatedAt: l.CreatedAt,
+	}
+}
@@ -5,53 +5,184 @@ import (
 	"time"
 
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/menutype"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Menu struct {
+	ID             uuid.UUID          `json:"id" gorm:"type:char(36);primaryKey"`
+	CanteenID      uuid.UUID          `json:"canteen_id" gorm:"type:char(36)"`
+	CategoryID     uuid.UUID          `json:"category_id" gorm:"type:char(36);index"`
+	Name           string             `json:"name" gorm:"type:varchar(128)"`
+	Type           menutype.Type      `json:"type" gorm:"type:varchar(16);default:SINGLE"`
+	Position       uint32             `json:"position" gorm:"type:integer unsigned"`
+	Price          uint32             `json:"price" gorm:"type:integer unsigned"`
+	Stock          uint32             `json:"stock" gorm:"type:integer unsigned"`
+	ParLevel       uint32             `json:"par_level" gorm:"type:integer unsigned"`
+	Image          string             `json:"image_url" gorm:"type:varchar(255)"`
+	Thumbnail      string             `json:"thumbnail_url" gorm:"type:varchar(255)"`
+	CreatedAt      time.Time          `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt      time.Time          `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt      gorm.DeletedAt     `gorm:"index"`
+	Promotions     []Promotion        `gorm:"foreignKey:MenuID"`
+	Modifiers      []ModifierGroup    `gorm:"foreignKey:MenuID"`
+	Components     []BundleItem       `gorm:"foreignKey:BundleID"`
+	Availabilities []MenuAvailability `gorm:"foreignKey:MenuID"`
+}
+
+type BundleItem struct {
+	ID        uuid.UUID `json:"id" gorm:"type:char(36);primaryKey"`
+	BundleID  uuid.UUID `json:"bundle_id" gorm:"type:char(36);index"`
+	MenuID    uuid.UUID `json:"menu_id" gorm:"type:char(36)"`
+	Quantity  uint32    `json:"quantity" gorm:"type:integer unsigned"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	Menu      Menu      `gorm:"foreignKey:MenuID"`
+}
+
+type MenuCategory struct {
 	ID        uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
-	CanteenID uuid.UUID      `json:"canteen_id" gorm:"type:char(36)"`
-	Name      string         `json:"name" gorm:"type:varchar(128)"`
-	Price     uint32         `json:"price" gorm:"type:integer unsigned"`
-	Stock     uint32         `json:"stock" gorm:"type:integer unsigned"`
+	CanteenID uuid.UUID      `json:"canteen_id" gorm:"type:char(36);index"`
+	Name      string         `json:"name" gorm:"type:varchar(64)"`
+	Position  uint32         `json:"position" gorm:"type:integer unsigned"`
 	CreatedAt time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
 	UpdatedAt time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
 	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
+func (m *Menu) EffectivePrice(now time.Time) (uint32, *Promotion) {
+	var active *Promotion
+
+	price := m.Price
+
+	for i := range m.Promotions {
+		promotion := &m.Promotions[i]
+
+		if !promotion.Active(now) {
+			continue
+		}
+
+		discounted := promotion.Apply(m.Price)
+		if active == nil || discounted < price {
+			price = discounted
+			active = promotion
+		}
+	}
+
+	return price, active
+}
+
+func (m *Menu) AvailableStock() uint32 {
+	if m.Type != menutype.Bundle {
+		return m.Stock
+	}
+
+	var stock uint32
+
+	for i, component := range m.Components {
+		if component.Quantity == 0 {
+			continue
+		}
+
+		available := component.Menu.Stock / component.Quantity
+		if i == 0 || available < stock {
+			stock = available
+		}
+	}
+
+	return stock
+}
+
 func (m *Menu) ParseToDTOResponseCreateMenu() dto.ResponseCreateMenu {
 	return dto.ResponseCreateMenu{
-		ID:        m.ID,
-		CanteenID: m.CanteenID,
-		Name:      m.Name,
-		Price:     m.Price,
-		Stock:     m.Stock,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:         m.ID,
+		CanteenID:  m.CanteenID,
+		CategoryID: m.CategoryID,
+		Name:       m.Name,
+		Type:       string(m.Type),
+		Position:   m.Position,
+		Price:      m.Price,
+		Stock:      m.AvailableStock(),
+		ParLevel:   m.ParLevel,
+		Image:      m.Image,
+		Thumbnail:  m.Thumbnail,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
 	}
 }
 
 func (m *Menu) ParseToDTOResponseUpdateMenu() dto.ResponseUpdateMenu {
 	return dto.ResponseUpdateMenu{
-		ID:        m.ID,
-		CanteenID: m.CanteenID,
-		Name:      m.Name,
-		Price:     m.Price,
-		Stock:     m.Stock,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:         m.ID,
+		CanteenID:  m.CanteenID,
+		CategoryID: m.CategoryID,
+		Name:       m.Name,
+		Type:       string(m.Type),
+		Position:   m.Position,
+		Price:      m.Price,
+		Stock:      m.AvailableStock(),
+		ParLevel:   m.ParLevel,
+		Image:      m.Image,
+		Thumbnail:  m.Thumbnail,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
 	}
 }
 
-func (m *Menu) ParseToDTOResponseGetMenuInfo() dto.ResponseGetMenuInfo {
+func (m *Menu) ParseToDTOResponseGetMenuInfo(now time.Time) dto.ResponseGetMenuInfo {
+	var parsedPromotion *dto.ResponsePromotion
+
+	discountedPrice, promotion := m.EffectivePrice(now)
+	if promotion != nil {
+		response := promotion.ParseToDTOResponsePromotion()
+		parsedPromotion = &response
+	}
+
+	var parsedModifier []dto.ResponseModifierGroup
+
+	for _, group := range m.Modifiers {
+		parsedModifier = append(parsedModifier, group.ParseToDTOResponseModifierGroup())
+	}
+
+	var parsedComponent []dto.ResponseBundleItem
+
+	for _, component := range m.Components {
+		parsedComponent = append(parsedComponent, dto.ResponseBundleItem{
+			MenuID:   component.MenuID,
+			Name:     component.Menu.Name,
+			Quantity: component.Quantity,
+		})
+	}
+
 	return dto.ResponseGetMenuInfo{
-		ID:        m.ID,
-		CanteenID: m.CanteenID,
-		Name:      m.Name,
-		Price:     m.Price,
-		Stock:     m.Stock,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:              m.ID,
+		CanteenID:       m.CanteenID,
+		CategoryID:      m.CategoryID,
+		Name:            m.Name,
+		Type:            string(m.Type),
+		Position:        m.Position,
+		Price:           m.Price,
+		DiscountedPrice: discountedPrice,
+		Promotion:       parsedPromotion,
+		Modifiers:       parsedModifier,
+		Components:      parsedComponent,
+		Available:       m.Available(now),
+		Stock:           m.AvailableStock(),
+		ParLevel:        m.ParLevel,
+		Image:           m.Image,
+		Thumbnail:       m.Thumbnail,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+func (c *MenuCategory) ParseToDTOResponseMenuCategory() dto.ResponseMenuCategory {
+	return dto.ResponseMenuCategory{
+		ID:        c.ID,
+		CanteenID: c.CanteenID,
+		Name:      c.Name,
+		Position:  c.Position,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
 	}
 }
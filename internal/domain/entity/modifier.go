@@ -0,0 +1,108 @@
+// Package entity defines database table and its relations
+package entity
+
+import (
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ModifierGroup struct {
+	ID          uuid.UUID        `json:"id" gorm:"type:char(36);primaryKey"`
+	MenuID      uuid.UUID        `json:"menu_id" gorm:"type:char(36);index"`
+	Name        string           `json:"name" gorm:"type:varchar(64)"`
+	Required    bool             `json:"required"`
+	MultiSelect bool             `json:"multi_select"`
+	MinSelect   uint32           `json:"min_select" gorm:"type:integer unsigned"`
+	MaxSelect   uint32           `json:"max_select" gorm:"type:integer unsigned"`
+	Position    uint32           `json:"position" gorm:"type:integer unsigned"`
+	CreatedAt   time.Time        `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt   time.Time        `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt   `gorm:"index"`
+	Options     []ModifierOption `gorm:"foreignKey:GroupID"`
+}
+
+type ModifierOption struct {
+	ID         uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
+	GroupID    uuid.UUID      `json:"group_id" gorm:"type:char(36);index"`
+	Name       string         `json:"name" gorm:"type:varchar(64)"`
+	PriceDelta int32          `json:"price_delta" gorm:"type:integer"`
+	Stock      *uint32        `json:"stock" gorm:"type:integer unsigned NULL"`
+	Position   uint32         `json:"position" gorm:"type:integer unsigned"`
+	CreatedAt  time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt  time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+}
+
+func (m *Menu) SelectOptions(optionIDs []uuid.UUID) ([]OrderItemOption, bool) {
+	chosen := make(map[uuid.UUID]bool, len(optionIDs))
+
+	for _, optionID := range optionIDs {
+		if chosen[optionID] {
+			return nil, false
+		}
+
+		chosen[optionID] = true
+	}
+
+	var selected []OrderItemOption
+
+	for _, group := range m.Modifiers {
+		var picks uint32
+
+		for _, option := range group.Options {
+			if !chosen[option.ID] {
+				continue
+			}
+
+			delete(chosen, option.ID)
+			picks++
+
+			selected = append(selected, OrderItemOption{
+				OptionID:   option.ID,
+				GroupName:  group.Name,
+				Name:       option.Name,
+				PriceDelta: option.PriceDelta,
+			})
+		}
+
+		if picks < group.MinSelect || picks > group.MaxSelect {
+			return nil, false
+		}
+	}
+
+	return selected, len(chosen) == 0
+}
+
+func (g *ModifierGroup) ParseToDTOResponseModifierGroup() dto.ResponseModifierGroup {
+	parsedOption := make([]dto.ResponseModifierOption, len(g.Options))
+
+	for i, option := range g.Options {
+		parsedOption[i] = option.ParseToDTOResponseModifierOption()
+	}
+
+	return dto.ResponseModifierGroup{
+		ID:          g.ID,
+		MenuID:      g.MenuID,
+		Name:        g.Name,
+		Required:    g.Required,
+		MultiSelect: g.MultiSelect,
+		MinSelect:   g.MinSelect,
+		MaxSelect:   g.MaxSelect,
+		Position:    g.Position,
+		Options:     parsedOption,
+	}
+}
+
+func (o *ModifierOption) ParseToDTOResponseModifierOption() dto.ResponseModifierOption {
+	return dto.ResponseModifierOption{
+		ID:         o.ID,
+		GroupID:    o.GroupID,
+		Name:       o.Name,
+		PriceDelta: o.PriceDelta,
+		Stock:      o.Stock,
+		Position:   o.Position,
+	}
+}
@@ -5,32 +5,103 @@ import (
 	"time"
 
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/orderstatus"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Order struct {
-	ID        uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
-	CanteenID uuid.UUID      `json:"canteen_id" gorm:"type:char(36);"`
-	UserID    uuid.UUID      `json:"user_id" gorm:"type:char(36);"`
-	MenuID    uuid.UUID      `json:"menu_id" gorm:"type:char(36);"`
-	Quantity  uint32         `json:"quantity" gorm:"type:integer unsigned"`
-	Status    string         `json:"status" gorm:"type:varchar(128)"`
-	CreatedAt time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
-	UpdatedAt time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID            uuid.UUID            `json:"id" gorm:"type:char(36);primaryKey"`
+	CanteenID     uuid.UUID            `json:"canteen_id" gorm:"type:char(36);"`
+	UserID        uuid.UUID            `json:"user_id" gorm:"type:char(36);"`
+	Subtotal      uint32               `json:"subtotal" gorm:"type:integer unsigned"`
+	VoucherCode   string               `json:"voucher_code" gorm:"type:varchar(32)"`
+	Discount      uint32               `json:"discount" gorm:"type:integer unsigned"`
+	PointsUsed    uint32               `json:"points_used" gorm:"type:integer unsigned"`
+	ServiceFee    uint32               `json:"service_fee" gorm:"type:integer unsigned"`
+	Tax           uint32               `json:"tax" gorm:"type:integer unsigned"`
+	Rounding      int32                `json:"rounding" gorm:"type:integer"`
+	Total         uint32               `json:"total" gorm:"type:integer unsigned"`
+	Status        orderstatus.Status   `json:"status" gorm:"type:varchar(128)"`
+	Reason        string               `json:"reason" gorm:"type:varchar(256)"`
+	ReceiptNumber string               `json:"receipt_number" gorm:"type:varchar(32);index"`
+	PaidAt        *time.Time           `json:"paid_at" gorm:"type:timestamp NULL"`
+	CreatedAt     time.Time            `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt     time.Time            `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt       `gorm:"index"`
+	OrderItems    []OrderItem          `gorm:"foreignKey:OrderID"`
+	Histories     []OrderStatusHistory `gorm:"foreignKey:OrderID"`
+}
+
+type OrderItem struct {
+	ID            uuid.UUID            `json:"id" gorm:"type:char(36);primaryKey"`
+	OrderID       uuid.UUID            `json:"order_id" gorm:"type:char(36);index"`
+	MenuID        uuid.UUID            `json:"menu_id" gorm:"type:char(36);"`
+	Name          string               `json:"name" gorm:"type:varchar(128)"`
+	Quantity      uint32               `json:"quantity" gorm:"type:integer unsigned"`
+	Price         uint32               `json:"price" gorm:"type:integer unsigned"`
+	OriginalPrice uint32               `json:"original_price" gorm:"type:integer unsigned"`
+	CreatedAt     time.Time            `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt     time.Time            `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt       `gorm:"index"`
+	Options       []OrderItemOption    `gorm:"foreignKey:OrderItemID"`
+	Components    []OrderItemComponent `gorm:"foreignKey:OrderItemID"`
+}
+
+type OrderItemComponent struct {
+	ID          uuid.UUID `json:"id" gorm:"type:char(36);primaryKey"`
+	OrderItemID uuid.UUID `json:"order_item_id" gorm:"type:char(36);index"`
+	MenuID      uuid.UUID `json:"menu_id" gorm:"type:char(36)"`
+	Quantity    uint32    `json:"quantity" gorm:"type:integer unsigned"`
+	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+}
+
+type OrderItemOption struct {
+	ID          uuid.UUID `json:"id" gorm:"type:char(36);primaryKey"`
+	OrderItemID uuid.UUID `json:"order_item_id" gorm:"type:char(36);index"`
+	OptionID    uuid.UUID `json:"option_id" gorm:"type:char(36)"`
+	GroupName   string    `json:"group_name" gorm:"type:varchar(64)"`
+	Name        string    `json:"name" gorm:"type:varchar(64)"`
+	PriceDelta  int32     `json:"price_delta" gorm:"type:integer"`
+	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+}
+
+type OrderStatusHistory struct {
+	ID         uuid.UUID          `json:"id" gorm:"type:char(36);primaryKey"`
+	OrderID    uuid.UUID          `json:"order_id" gorm:"type:char(36);index"`
+	FromStatus orderstatus.Status `json:"from_status" gorm:"type:varchar(128)"`
+	ToStatus   orderstatus.Status `json:"to_status" gorm:"type:varchar(128)"`
+	ChangedBy  uuid.UUID          `json:"changed_by" gorm:"type:char(36)"`
+	CreatedAt  time.Time          `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+}
+
+func (o *Order) CalculateSubtotal() uint32 {
+	var total uint32
+
+	for _, item := range o.OrderItems {
+		total += item.Price * item.Quantity
+	}
+
+	return total
 }
 
 func (o *Order) ParseToDTOResponseCreateOrder() dto.ResponseCreateOrder {
 	return dto.ResponseCreateOrder{
-		ID:        o.ID,
-		CanteenID: o.CanteenID,
-		UserID:    o.UserID,
-		MenuID:    o.MenuID,
-		Quantity:  o.Quantity,
-		Status:    o.Status,
-		CreatedAt: o.CreatedAt,
-		UpdatedAt: o.UpdatedAt,
+		ID:          o.ID,
+		CanteenID:   o.CanteenID,
+		UserID:      o.UserID,
+		Subtotal:    o.Subtotal,
+		VoucherCode: o.VoucherCode,
+		Discount:    o.Discount,
+		PointsUsed:  o.PointsUsed,
+		ServiceFee:  o.ServiceFee,
+		Tax:         o.Tax,
+		Rounding:    o.Rounding,
+		Total:       o.Total,
+		Status:      o.Status,
+		Items:       o.ParseToDTOResponseOrderItems(),
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
 	}
 }
 
@@ -39,36 +110,145 @@ func (o *Order) ParseToDTOResponseUpdateOrder() dto.ResponseUpdateOrder {
 		ID:        o.ID,
 		CanteenID: o.CanteenID,
 		UserID:    o.UserID,
-		MenuID:    o.MenuID,
-		Quantity:  o.Quantity,
+		Total:     o.Total,
 		Status:    o.Status,
+		Reason:    o.Reason,
+		Items:     o.ParseToDTOResponseOrderItems(),
 		CreatedAt: o.CreatedAt,
 		UpdatedAt: o.UpdatedAt,
 	}
 }
 
 func (o *Order) ParseToDTOResponseGetOrderInfo() dto.ResponseGetOrderInfo {
+	parsedHistory := make([]dto.ResponseOrderStatusHistory, len(o.Histories))
+
+	for i, history := range o.Histories {
+		parsedHistory[i] = history.ParseToDTOResponseOrderStatusHistory()
+	}
+
 	return dto.ResponseGetOrderInfo{
-		ID:        o.ID,
-		CanteenID: o.CanteenID,
-		UserID:    o.UserID,
-		MenuID:    o.MenuID,
-		Quantity:  o.Quantity,
-		Status:    o.Status,
-		CreatedAt: o.CreatedAt,
-		UpdatedAt: o.UpdatedAt,
+		ID:            o.ID,
+		CanteenID:     o.CanteenID,
+		UserID:        o.UserID,
+		Subtotal:      o.Subtotal,
+		VoucherCode:   o.VoucherCode,
+		Discount:      o.Discount,
+		PointsUsed:    o.PointsUsed,
+		ServiceFee:    o.ServiceFee,
+		Tax:           o.Tax,
+		Rounding:      o.Rounding,
+		Total:         o.Total,
+		Status:        o.Status,
+		Reason:        o.Reason,
+		ReceiptNumber: o.ReceiptNumber,
+		PaidAt:        o.PaidAt,
+		Items:         o.ParseToDTOResponseOrderItems(),
+		Histories:     parsedHistory,
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
 	}
 }
 
 func (o *Order) ParseToDTOResponseGetOrderList() dto.ResponseGetOrderList {
 	return dto.ResponseGetOrderList{
-		ID:        o.ID,
-		CanteenID: o.CanteenID,
-		UserID:    o.UserID,
-		MenuID:    o.MenuID,
-		Quantity:  o.Quantity,
-		Status:    o.Status,
-		CreatedAt: o.CreatedAt,
-		UpdatedAt: o.UpdatedAt,
+		ID:          o.ID,
+		CanteenID:   o.CanteenID,
+		UserID:      o.UserID,
+		Subtotal:    o.Subtotal,
+		VoucherCode: o.VoucherCode,
+		Discount:    o.Discount,
+		PointsUsed:  o.PointsUsed,
+		ServiceFee:  o.ServiceFee,
+		Tax:         o.Tax,
+		Rounding:    o.Rounding,
+		Total:       o.Total,
+		Status:      o.Status,
+		Items:       o.ParseToDTOResponseOrderItems(),
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}
+}
+
+func (o *Order) ParseToDTOResponseOrderItems() []dto.ResponseOrderItem {
+	parsedOrderItem := make([]dto.ResponseOrderItem, len(o.OrderItems))
+
+	for i, item := range o.OrderItems {
+		parsedOrderItem[i] = item.ParseToDTOResponseOrderItem()
+	}
+
+	return parsedOrderItem
+}
+
+func (i *OrderItem) ParseToDTOResponseOrderItem() dto.ResponseOrderItem {
+	parsedOption := make([]dto.ResponseOrderItemOption, len(i.Options))
+
+	for j, option := range i.Options {
+		parsedOption[j] = dto.ResponseOrderItemOption{
+			OptionID:   option.OptionID,
+			GroupName:  option.GroupName,
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+		}
+	}
+
+	return dto.ResponseOrderItem{
+		ID:            i.ID,
+		MenuID:        i.MenuID,
+		Name:          i.Name,
+		Quantity:      i.Quantity,
+		Price:         i.Price,
+		OriginalPrice: i.OriginalPrice,
+		Options:       parsedOption,
+	}
+}
+
+func (h *OrderStatusHistory) ParseToDTOResponseOrderStatusHistory() dto.ResponseOrderStatusHistory {
+	return dto.ResponseOrderStatusHistory{
+		FromStatus: h.FromStatus,
+		ToStatus:   h.ToStatus,
+		ChangedBy:  h.ChangedBy,
+		CreatedAt:  h.CreatedAt,
+	}
+}
+
+func (o *Order) ParseToDTOResponseGetReceipt(canteen Canteen, payment Payment) dto.ResponseGetReceipt {
+	parsedItem := make([]dto.ResponseReceiptItem, len(o.OrderItems))
+
+	for i, item := range o.OrderItems {
+		parsedItem[i] = dto.ResponseReceiptItem{
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.Price,
+			Amount:    item.Price * item.Quantity,
+		}
+
+		for _, option := range item.Options {
+			parsedItem[i].Options = append(parsedItem[i].Options, option.Name)
+		}
+	}
+
+	subtotal := o.Subtotal
+	if subtotal == 0 {
+		subtotal = o.CalculateSubtotal()
+	}
+
+	return dto.ResponseGetReceipt{
+		ReceiptNumber: o.ReceiptNumber,
+		OrderID:       o.ID,
+		CanteenID:     canteen.ID,
+		CanteenName:   canteen.Name,
+		Items:         parsedItem,
+		Subtotal:      subtotal,
+		VoucherCode:   o.VoucherCode,
+		Discount:      o.Discount,
+		PointsUsed:    o.PointsUsed,
+		ServiceFee:    o.ServiceFee,
+		Tax:           o.Tax,
+		Rounding:      o.Rounding,
+		Total:         o.Total,
+		PaymentMethod: string(payment.Method),
+		Status:        o.Status,
+		OrderedAt:     o.CreatedAt,
+		PaidAt:        o.PaidAt,
 	}
 }
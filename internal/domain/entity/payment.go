@@ -4,17 +4,85 @@ package entity
 import (
 	"time"
 
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentmethod"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/refundstatus"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Payment struct {
-	ID          uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
-	OrderID     uuid.UUID      `json:"order_id" gorm:"type:char(36);"`
-	UserID      uuid.UUID      `json:"user_id" gorm:"type:char(36);"`
-	Price       uint32         `json:"price" gorm:"type:integer unsigned"`
-	RedirectURL string         `json:"redirect_url" gorm:"type:varchar(256)"`
-	CreatedAt   time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
-	UpdatedAt   time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID             uuid.UUID             `json:"id" gorm:"type:char(36);primaryKey"`
+	OrderID        uuid.UUID             `json:"order_id" gorm:"type:char(36);"`
+	UserID         uuid.UUID             `json:"user_id" gorm:"type:char(36);"`
+	Price          uint32                `json:"price" gorm:"type:integer unsigned"`
+	RefundedAmount uint32                `json:"refunded_amount" gorm:"type:integer unsigned"`
+	Status         paymentstatus.Status  `json:"status" gorm:"type:varchar(128)"`
+	Method         paymentmethod.Method  `json:"method" gorm:"type:varchar(16)"`
+	Purpose        paymentmethod.Purpose `json:"purpose" gorm:"type:varchar(16)"`
+	TransactionID  string                `json:"transaction_id" gorm:"type:varchar(128)"`
+	RedirectURL    string                `json:"redirect_url" gorm:"type:varchar(256)"`
+	ConfirmedBy    uuid.UUID             `json:"confirmed_by" gorm:"type:char(36)"`
+	SettledAt      *time.Time            `json:"settled_at" gorm:"type:timestamp NULL"`
+	CreatedAt      time.Time             `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt      time.Time             `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt      gorm.DeletedAt        `gorm:"index"`
+}
+
+type PaymentNotification struct {
+	ID                uuid.UUID `json:"id" gorm:"type:char(36);primaryKey"`
+	OrderID           string    `json:"order_id" gorm:"type:varchar(128)"`
+	TransactionID     string    `json:"transaction_id" gorm:"type:varchar(128);uniqueIndex:idx_payment_notification"`
+	TransactionStatus string    `json:"transaction_status" gorm:"type:varchar(64);uniqueIndex:idx_payment_notification"`
+	StatusCode        string    `json:"status_code" gorm:"type:varchar(8)"`
+	GrossAmount       string    `json:"gross_amount" gorm:"type:varchar(32)"`
+	SignatureKey      string    `json:"signature_key" gorm:"type:varchar(256)"`
+	Processed         bool      `json:"processed" gorm:"type:boolean"`
+	CreatedAt         time.Time `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt         time.Time `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+}
+
+func (n *PaymentNotification) ParseToDTOResponseGetPaymentNotification() dto.ResponseGetPaymentNotification {
+	return dto.ResponseGetPaymentNotification{
+		ID:                n.ID,
+		OrderID:           n.OrderID,
+		TransactionID:     n.TransactionID,
+		TransactionStatus: n.TransactionStatus,
+		StatusCode:        n.StatusCode,
+		GrossAmount:       n.GrossAmount,
+		Processed:         n.Processed,
+		CreatedAt:         n.CreatedAt,
+		UpdatedAt:         n.UpdatedAt,
+	}
+}
+
+type Refund struct {
+	ID          uuid.UUID           `json:"id" gorm:"type:char(36);primaryKey"`
+	PaymentID   uuid.UUID           `json:"payment_id" gorm:"type:char(36);index"`
+	OrderID     uuid.UUID           `json:"order_id" gorm:"type:char(36);"`
+	UserID      uuid.UUID           `json:"user_id" gorm:"type:char(36);index"`
+	Amount      uint32              `json:"amount" gorm:"type:integer unsigned"`
+	Reason      string              `json:"reason" gorm:"type:varchar(256)"`
+	Status      refundstatus.Status `json:"status" gorm:"type:varchar(16);default:COMPLETED"`
+	Attempts    uint32              `json:"attempts" gorm:"type:integer unsigned"`
+	RequestedBy uuid.UUID           `json:"requested_by" gorm:"type:char(36)"`
+	CompletedAt *time.Time          `json:"completed_at" gorm:"type:timestamp NULL"`
+	CreatedAt   time.Time           `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt   time.Time           `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt      `gorm:"index"`
+}
+
+func (r *Refund) ParseToDTOResponseGetRefund() dto.ResponseGetRefund {
+	return dto.ResponseGetRefund{
+		ID:          r.ID,
+		PaymentID:   r.PaymentID,
+		OrderID:     r.OrderID,
+		Amount:      r.Amount,
+		Reason:      r.Reason,
+		Status:      string(r.Status),
+		Attempts:    r.Attempts,
+		RequestedBy: r.RequestedBy,
+		CreatedAt:   r.CreatedAt,
+	}
 }
@@ -0,0 +1,87 @@
+// Package entity defines database table and its relations
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/discount"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Promotion struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
+	MenuID      uuid.UUID      `json:"menu_id" gorm:"type:char(36);index"`
+	Name        string         `json:"name" gorm:"type:varchar(64)"`
+	Type        discount.Type  `json:"type" gorm:"type:varchar(16)"`
+	Value       uint32         `json:"value" gorm:"type:integer unsigned"`
+	Weekdays    uint8          `json:"weekdays" gorm:"type:tinyint unsigned"`
+	StartMinute uint16         `json:"start_minute" gorm:"type:smallint unsigned"`
+	EndMinute   uint16         `json:"end_minute" gorm:"type:smallint unsigned"`
+	ValidFrom   time.Time      `json:"valid_from" gorm:"type:timestamp"`
+	ValidUntil  *time.Time     `json:"valid_until" gorm:"type:timestamp NULL"`
+	CreatedAt   time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt   time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+func (p *Promotion) Active(now time.Time) bool {
+	if now.Before(p.ValidFrom) || (p.ValidUntil != nil && !now.Before(*p.ValidUntil)) {
+		return false
+	}
+
+	return withinWindow(now, p.Weekdays, p.StartMinute, p.EndMinute)
+}
+
+func withinWindow(now time.Time, weekdays uint8, startMinute uint16, endMinute uint16) bool {
+	if weekdays != 0 && weekdays&(1<<uint(now.Weekday())) == 0 {
+		return false
+	}
+
+	minute := uint16(now.Hour()*60 + now.Minute())
+
+	switch {
+	case startMinute < endMinute:
+		return minute >= startMinute && minute < endMinute
+	case startMinute > endMinute:
+		return minute >= startMinute || minute < endMinute
+	}
+
+	return true
+}
+
+func (p *Promotion) Apply(price uint32) uint32 {
+	amount := p.Value
+
+	if p.Type == discount.Percentage {
+		amount = uint32((uint64(price)*uint64(p.Value) + 5000) / 10000)
+	}
+
+	return price - min(amount, price)
+}
+
+func (p *Promotion) ParseToDTOResponsePromotion() dto.ResponsePromotion {
+	var weekdays []int
+
+	for day := range 7 {
+		if p.Weekdays&(1<<day) != 0 {
+			weekdays = append(weekdays, day)
+		}
+	}
+
+	return dto.ResponsePromotion{
+		ID:         p.ID,
+		MenuID:     p.MenuID,
+		Name:       p.Name,
+		Type:       string(p.Type),
+		Value:      p.Value,
+		Weekdays:   weekdays,
+		StartTime:  fmt.Sprintf("%02d:%02d", p.StartMinute/60, p.StartMinute%60),
+		EndTime:    fmt.Sprintf("%02d:%02d", p.EndMinute/60, p.EndMinute%60),
+		ValidFrom:  p.ValidFrom,
+		ValidUntil: p.ValidUntil,
+		CreatedAt:  p.CreatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+// Package entity defines database table and its relations
+package entity
+
+import (
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentstatus"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/reconciliation"
+	"github.com/google/uuid"
+)
+
+type ReconciliationReport struct {
+	ID         uuid.UUID             `json:"id" gorm:"type:char(36);primaryKey"`
+	Matched    uint32                `json:"matched" gorm:"type:integer unsigned"`
+	Fixed      uint32                `json:"fixed" gorm:"type:integer unsigned"`
+	Mismatched uint32                `json:"mismatched" gorm:"type:integer unsigned"`
+	Failed     uint32                `json:"failed" gorm:"type:integer unsigned"`
+	CreatedAt  time.Time             `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	Entries    []ReconciliationEntry `gorm:"foreignKey:ReportID"`
+}
+
+type ReconciliationEntry struct {
+	ID             uuid.UUID             `json:"id" gorm:"type:char(36);primaryKey"`
+	ReportID       uuid.UUID             `json:"report_id" gorm:"type:char(36);index"`
+	PaymentID      uuid.UUID             `json:"payment_id" gorm:"type:char(36)"`
+	LocalStatus    paymentstatus.Status  `json:"local_status" gorm:"type:varchar(128)"`
+	ProviderStatus string                `json:"provider_status" gorm:"type:varchar(128)"`
+	LocalAmount    uint32                `json:"local_amount" gorm:"type:integer unsigned"`
+	ProviderAmount uint32                `json:"provider_amount" gorm:"type:integer unsigned"`
+	Result         reconciliation.Result `json:"result" gorm:"type:varchar(32)"`
+	CreatedAt      time.Time             `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+}
+
+func (r *ReconciliationReport) ParseToDTOResponseGetReconciliationReport() dto.ResponseGetReconciliationReport {
+	parsedEntry := make([]dto.ResponseReconciliationEntry, len(r.Entries))
+
+	for i, entry := range r.Entries {
+		parsedEntry[i] = entry.ParseToDTOResponseReconciliationEntry()
+	}
+
+	return dto.ResponseGetReconciliationReport{
+		ID:         r.ID,
+		Matched:    r.Matched,
+		Fixed:      r.Fixed,
+		Mismatched: r.Mismatched,
+		Failed:     r.Failed,
+		Entries:    parsedEntry,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func (e *ReconciliationEntry) ParseToDTOResponseReconciliationEntry() dto.ResponseReconciliationEntry {
+	return dto.ResponseReconciliationEntry{
+		PaymentID:      e.PaymentID,
+		LocalStatus:    string(e.LocalStatus),
+		ProviderStatus: e.ProviderStatus,
+		LocalAmount:    e.LocalAmount,
+		ProviderAmount: e.ProviderAmount,
+		Result:         string(e.Result),
+	}
+}
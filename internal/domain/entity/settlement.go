@@ -0,0 +1,154 @@
+// Package entity defines database table and its relations
+package entity
+
+import (
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/paymentmethod"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/settlement"
+	"github.com/google/uuid"
+)
+
+type Payout struct {
+	ID               uuid.UUID          `json:"id" gorm:"type:char(36);primaryKey"`
+	CanteenID        uuid.UUID          `json:"canteen_id" gorm:"type:char(36);index"`
+	Period           settlement.Period  `json:"period" gorm:"type:varchar(16)"`
+	PeriodStart      time.Time          `json:"period_start" gorm:"type:timestamp"`
+	PeriodEnd        time.Time          `json:"period_end" gorm:"type:timestamp"`
+	PaymentCount     uint32             `json:"payment_count" gorm:"type:integer unsigned"`
+	GrossAmount      uint32             `json:"gross_amount" gorm:"type:integer unsigned"`
+	RefundedAmount   uint32             `json:"refunded_amount" gorm:"type:integer unsigned"`
+	CashAmount       uint32             `json:"cash_amount" gorm:"type:integer unsigned"`
+	CommissionRate   uint32             `json:"commission_rate" gorm:"type:integer unsigned"`
+	Commission       int64              `json:"commission" gorm:"type:bigint"`
+	AdjustmentAmount int64              `json:"adjustment_amount" gorm:"type:bigint"`
+	NetAmount        int64              `json:"net_amount" gorm:"type:bigint"`
+	Status           settlement.Status  `json:"status" gorm:"type:varchar(16)"`
+	PaidBy           uuid.UUID          `json:"paid_by" gorm:"type:char(36)"`
+	PaidAt           *time.Time         `json:"paid_at" gorm:"type:timestamp NULL"`
+	CreatedAt        time.Time          `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt        time.Time          `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	Items            []PayoutItem       `gorm:"foreignKey:PayoutID"`
+	Adjustments      []PayoutAdjustment `gorm:"foreignKey:PayoutID"`
+}
+
+type PayoutItem struct {
+	ID             uuid.UUID            `json:"id" gorm:"type:char(36);primaryKey"`
+	PayoutID       uuid.UUID            `json:"payout_id" gorm:"type:char(36);index"`
+	PaymentID      uuid.UUID            `json:"payment_id" gorm:"type:char(36);uniqueIndex"`
+	OrderID        uuid.UUID            `json:"order_id" gorm:"type:char(36)"`
+	Method         paymentmethod.Method `json:"method" gorm:"type:varchar(16)"`
+	Amount         uint32               `json:"amount" gorm:"type:integer unsigned"`
+	RefundedAmount uint32               `json:"refunded_amount" gorm:"type:integer unsigned"`
+	PaymentDate    time.Time            `json:"payment_date" gorm:"type:timestamp"`
+	CreatedAt      time.Time            `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+}
+
+type PayoutAdjustment struct {
+	ID         uuid.UUID            `json:"id" gorm:"type:char(36);primaryKey"`
+	PayoutID   uuid.UUID            `json:"payout_id" gorm:"type:char(36);index"`
+	RefundID   uuid.UUID            `json:"refund_id" gorm:"type:char(36);uniqueIndex"`
+	PaymentID  uuid.UUID            `json:"payment_id" gorm:"type:char(36)"`
+	OrderID    uuid.UUID            `json:"order_id" gorm:"type:char(36)"`
+	Method     paymentmethod.Method `json:"method" gorm:"type:varchar(16)"`
+	Amount     int64                `json:"amount" gorm:"type:bigint"`
+	RefundDate time.Time            `json:"refund_date" gorm:"type:timestamp"`
+	CreatedAt  time.Time            `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+}
+
+func (p *Payout) CalculateNet() {
+	p.PaymentCount = uint32(len(p.Items))
+	p.GrossAmount = 0
+	p.RefundedAmount = 0
+	p.CashAmount = 0
+	p.AdjustmentAmount = 0
+
+	for _, item := range p.Items {
+		p.GrossAmount += item.Amount
+		p.RefundedAmount += item.RefundedAmount
+
+		if item.Method == paymentmethod.Cash {
+			p.CashAmount += item.Amount - item.RefundedAmount
+		}
+	}
+
+	cash := int64(p.CashAmount)
+
+	for _, adjustment := range p.Adjustments {
+		p.AdjustmentAmount += adjustment.Amount
+
+		if adjustment.Method == paymentmethod.Cash {
+			cash += adjustment.Amount
+		}
+	}
+
+	sales := int64(p.GrossAmount) - int64(p.RefundedAmount) + p.AdjustmentAmount
+
+	commission := (uint64(max(sales, -sales))*uint64(p.CommissionRate) + 5000) / 10000
+
+	p.Commission = int64(commission)
+	if sales < 0 {
+		p.Commission = -p.Commission
+	}
+
+	p.NetAmount = sales - p.Commission - cash
+}
+
+func (p *Payout) ParseToDTOResponseGetPayout() dto.ResponseGetPayout {
+	parsedItem := make([]dto.ResponsePayoutItem, len(p.Items))
+
+	for i, item := range p.Items {
+		parsedItem[i] = item.ParseToDTOResponsePayoutItem()
+	}
+
+	parsedAdjustment := make([]dto.ResponsePayoutAdjustment, len(p.Adjustments))
+
+	for i, adjustment := range p.Adjustments {
+		parsedAdjustment[i] = adjustment.ParseToDTOResponsePayoutAdjustment()
+	}
+
+	return dto.ResponseGetPayout{
+		ID:               p.ID,
+		CanteenID:        p.CanteenID,
+		Period:           string(p.Period),
+		PeriodStart:      p.PeriodStart,
+		PeriodEnd:        p.PeriodEnd,
+		PaymentCount:     p.PaymentCount,
+		GrossAmount:      p.GrossAmount,
+		RefundedAmount:   p.RefundedAmount,
+		CashAmount:       p.CashAmount,
+		CommissionRate:   p.CommissionRate,
+		Commission:       p.Commission,
+		AdjustmentAmount: p.AdjustmentAmount,
+		NetAmount:        p.NetAmount,
+		Status:           string(p.Status),
+		PaidBy:           p.PaidBy,
+		PaidAt:           p.PaidAt,
+		Items:            parsedItem,
+		Adjustments:      parsedAdjustment,
+		CreatedAt:        p.CreatedAt,
+	}
+}
+
+func (i *PayoutItem) ParseToDTOResponsePayoutItem() dto.ResponsePayoutItem {
+	return dto.ResponsePayoutItem{
+		PaymentID:      i.PaymentID,
+		OrderID:        i.OrderID,
+		Method:         string(i.Method),
+		Amount:         i.Amount,
+		RefundedAmount: i.RefundedAmount,
+		PaymentDate:    i.PaymentDate,
+	}
+}
+
+func (a *PayoutAdjustment) ParseToDTOResponsePayoutAdjustment() dto.ResponsePayoutAdjustment {
+	return dto.ResponsePayoutAdjustment{
+		RefundID:   a.RefundID,
+		PaymentID:  a.PaymentID,
+		OrderID:    a.OrderID,
+		Method:     string(a.Method),
+		Amount:     a.Amount,
+		RefundDate: a.RefundDate,
+	}
+}
@@ -0,0 +1,99 @@
+// Package entity defines database table and its relations
+package entity
+
+import (
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/discount"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Voucher struct {
+	ID           uuid.UUID      `json:"id" gorm:"type:char(36);primaryKey"`
+	Code         string         `json:"code" gorm:"type:varchar(32);uniqueIndex"`
+	CreatedBy    uuid.UUID      `json:"created_by" gorm:"type:char(36)"`
+	CanteenID    uuid.UUID      `json:"canteen_id" gorm:"type:char(36);index"`
+	MenuID       uuid.UUID      `json:"menu_id" gorm:"type:char(36)"`
+	Type         discount.Type  `json:"type" gorm:"type:varchar(16)"`
+	Value        uint32         `json:"value" gorm:"type:integer unsigned"`
+	MinSpend     uint32         `json:"min_spend" gorm:"type:integer unsigned"`
+	MaxDiscount  uint32         `json:"max_discount" gorm:"type:integer unsigned"`
+	UsageLimit   uint32         `json:"usage_limit" gorm:"type:integer unsigned"`
+	PerUserLimit uint32         `json:"per_user_limit" gorm:"type:integer unsigned"`
+	UsedCount    uint32         `json:"used_count" gorm:"type:integer unsigned"`
+	ValidFrom    time.Time      `json:"valid_from" gorm:"type:timestamp"`
+	ValidUntil   time.Time      `json:"valid_until" gorm:"type:timestamp"`
+	CreatedAt    time.Time      `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt    time.Time      `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+type VoucherRedemption struct {
+	ID        uuid.UUID `json:"id" gorm:"type:char(36);primaryKey"`
+	VoucherID uuid.UUID `json:"voucher_id" gorm:"type:char(36);index"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:char(36);index"`
+	OrderID   uuid.UUID `json:"order_id" gorm:"type:char(36);uniqueIndex"`
+	Discount  uint32    `json:"discount" gorm:"type:integer unsigned"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+}
+
+func (v *Voucher) Applicable(order *Order, now time.Time) bool {
+	if now.Before(v.ValidFrom) || now.After(v.ValidUntil) {
+		return false
+	}
+
+	if v.CanteenID != uuid.Nil && v.CanteenID != order.CanteenID {
+		return false
+	}
+
+	return order.CalculateSubtotal() >= v.MinSpend
+}
+
+func (v *Voucher) CalculateDiscount(order *Order) uint32 {
+	eligible := order.CalculateSubtotal()
+
+	if v.MenuID != uuid.Nil {
+		eligible = 0
+
+		for _, item := range order.OrderItems {
+			if item.MenuID == v.MenuID {
+				eligible += item.Price * item.Quantity
+			}
+		}
+	}
+
+	amount := v.Value
+
+	if v.Type == discount.Percentage {
+		amount = uint32((uint64(eligible)*uint64(v.Value) + 5000) / 10000)
+	}
+
+	if v.MaxDiscount != 0 {
+		amount = min(amount, v.MaxDiscount)
+	}
+
+	return min(amount, eligible)
+}
+
+func (v *Voucher) ParseToDTOResponseGetVoucher() dto.ResponseGetVoucher {
+	return dto.ResponseGetVoucher{
+		ID:           v.ID,
+		Code:         v.Code,
+		CreatedBy:    v.CreatedBy,
+		CanteenID:    v.CanteenID,
+		MenuID:       v.MenuID,
+		Type:         string(v.Type),
+		Value:        v.Value,
+		MinSpend:     v.MinSpend,
+		MaxDiscount:  v.MaxDiscount,
+		UsageLimit:   v.UsageLimit,
+		PerUserLimit: v.PerUserLimit,
+		UsedCount:    v.UsedCount,
+		ValidFrom:    v.ValidFrom,
+		ValidUntil:   v.ValidUntil,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+	}
+}
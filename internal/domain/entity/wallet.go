@@ -0,0 +1,49 @@
+// Package entity defines database table and its relations
+package entity
+
+import (
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/ledger"
+	"github.com/google/uuid"
+)
+
+type Wallet struct {
+	ID        uuid.UUID `json:"id" gorm:"type:char(36);primaryKey"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:char(36);uniqueIndex"`
+	Balance   uint32    `json:"balance" gorm:"type:integer unsigned"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"type:timestamp;autoUpdateTime"`
+}
+
+type LedgerEntry struct {
+	ID            uuid.UUID        `json:"id" gorm:"type:char(36);primaryKey"`
+	TransactionID uuid.UUID        `json:"transaction_id" gorm:"type:char(36);index"`
+	Account       string           `json:"account" gorm:"type:varchar(64);index"`
+	Direction     ledger.Direction `json:"direction" gorm:"type:varchar(8)"`
+	Amount        uint32           `json:"amount" gorm:"type:integer unsigned"`
+	PaymentID     uuid.UUID        `json:"payment_id" gorm:"type:char(36)"`
+	Description   string           `json:"description" gorm:"type:varchar(128)"`
+	CreatedAt     time.Time        `json:"created_at" gorm:"type:timestamp;autoCreateTime"`
+}
+
+func (w *Wallet) ParseToDTOResponseGetWallet() dto.ResponseGetWallet {
+	return dto.ResponseGetWallet{
+		UserID:    w.UserID,
+		Balance:   w.Balance,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func (l *LedgerEntry) ParseToDTOResponseLedgerEntry() dto.ResponseLedgerEntry {
+	return dto.ResponseLedgerEntry{
+		ID:            l.ID,
+		TransactionID: l.TransactionID,
+		Direction:     string(l.Direction),
+		Amount:        l.Amount,
+		PaymentID:     l.PaymentID,
+		Description:   l.Description,
+		CreatedAt:     l.CreatedAt,
+	}
+}
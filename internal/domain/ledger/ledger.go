@@ -0,0 +1,21 @@
+// Package ledger defines the accounts and entry directions of the double-entry wallet ledger
+package ledger
+
+import "github.com/google/uuid"
+
+type Direction string
+
+const (
+	Debit  Direction = "DEBIT"
+	Credit Direction = "CREDIT"
+)
+
+const GatewayAccount = "GATEWAY"
+
+func WalletAccount(userID uuid.UUID) string {
+	return "WALLET:" + userID.String()
+}
+
+func CanteenAccount(canteenID uuid.UUID) string {
+	return "CANTEEN:" + canteenID.String()
+}
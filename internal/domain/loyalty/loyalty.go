@@ -0,0 +1,11 @@
+// Package loyalty defines the entry kinds of the loyalty points ledger
+package loyalty
+
+type Kind string
+
+const (
+	Earn    Kind = "EARN"
+	Redeem  Kind = "REDEEM"
+	Restore Kind = "RESTORE"
+	Expire  Kind = "EXPIRE"
+)
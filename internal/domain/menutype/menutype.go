@@ -0,0 +1,9 @@
+// Package menutype defines whether a menu is sold on its own or as a bundle of other menus
+package menutype
+
+type Type string
+
+const (
+	Single Type = "SINGLE"
+	Bundle Type = "BUNDLE"
+)
@@ -0,0 +1,61 @@
+// Package orderstatus defines the order lifecycle and the transitions allowed between each status
+package orderstatus
+
+import (
+	"fmt"
+	"slices"
+)
+
+type Status string
+
+const (
+	Unpaid            Status = "UNPAID"
+	AwaitingCash      Status = "AWAITING_CASH"
+	Paid              Status = "PAID"
+	Waiting           Status = "WAITING"
+	Cooking           Status = "COOKING"
+	Ready             Status = "READY"
+	Completed         Status = "COMPLETED"
+	FeedbackSent      Status = "FEEDBACKSENT"
+	Cancelled         Status = "CANCELLED"
+	Expired           Status = "EXPIRED"
+	Refunded          Status = "REFUNDED"
+	PartiallyRefunded Status = "PARTIALLY_REFUNDED"
+)
+
+var transitions = map[Status][]Status{
+	Unpaid:            {Paid, AwaitingCash, Cancelled, Expired},
+	AwaitingCash:      {Paid, Cancelled, Expired},
+	Paid:              {Waiting, Cancelled},
+	Waiting:           {Cooking, Cancelled},
+	Cooking:           {Ready},
+	Ready:             {Completed},
+	Completed:         {FeedbackSent},
+	Cancelled:         {Refunded, PartiallyRefunded},
+	Expired:           {Refunded},
+	PartiallyRefunded: {Refunded, PartiallyRefunded},
+}
+
+type TransitionError struct {
+	From Status
+	To   Status
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("order status cannot change from %s to %s", e.From, e.To)
+}
+
+func (s Status) CanTransitionTo(next Status) bool {
+	return slices.Contains(transitions[s], next)
+}
+
+func (s Status) Transition(next Status) error {
+	if !s.CanTransitionTo(next) {
+		return &TransitionError{
+			From: s,
+			To:   next,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+// Package paymentmethod defines how a payment is funded and what it is paying for
+package paymentmethod
+
+type Method string
+
+const (
+	Gateway Method = "GATEWAY"
+	Wallet  Method = "WALLET"
+	Cash    Method = "CASH"
+)
+
+type Purpose string
+
+const (
+	Order Purpose = "ORDER"
+	TopUp Purpose = "TOPUP"
+)
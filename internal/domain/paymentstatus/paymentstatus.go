@@ -0,0 +1,39 @@
+// Package paymentstatus defines the payment statuses and maps the payment provider transaction statuses into them
+package paymentstatus
+
+type Status string
+
+const (
+	Pending  Status = "PENDING"
+	Settled  Status = "SETTLED"
+	Failed   Status = "FAILED"
+	Expired  Status = "EXPIRED"
+	Refunded Status = "REFUNDED"
+)
+
+var ranks = map[Status]int{
+	Pending:  0,
+	Failed:   1,
+	Expired:  1,
+	Settled:  2,
+	Refunded: 3,
+}
+
+func (s Status) Supersedes(current Status) bool {
+	return ranks[s] > ranks[current]
+}
+
+func FromTransactionStatus(transactionStatus string) Status {
+	switch transactionStatus {
+	case "capture", "settlement", "partial_refund":
+		return Settled
+	case "deny", "cancel", "failure":
+		return Failed
+	case "expire":
+		return Expired
+	case "refund":
+		return Refunded
+	default:
+		return Pending
+	}
+}
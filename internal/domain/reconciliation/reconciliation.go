@@ -0,0 +1,11 @@
+// Package reconciliation defines the outcome of comparing a local payment against the payment provider
+package reconciliation
+
+type Result string
+
+const (
+	Failed     Result = "FAILED"
+	Matched    Result = "MATCHED"
+	Fixed      Result = "FIXED"
+	Mismatched Result = "MISMATCHED"
+)
@@ -0,0 +1,9 @@
+// Package refundstatus defines the refund statuses while the money is returned through the payment provider
+package refundstatus
+
+type Status string
+
+const (
+	Pending   Status = "PENDING"
+	Completed Status = "COMPLETED"
+)
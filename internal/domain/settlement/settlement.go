@@ -0,0 +1,30 @@
+// Package settlement defines the payout periods and statuses used to settle what the platform owes each canteen
+package settlement
+
+import "time"
+
+type Period string
+
+const (
+	Daily  Period = "DAILY"
+	Weekly Period = "WEEKLY"
+)
+
+type Status string
+
+const (
+	Pending Status = "PENDING"
+	Paid    Status = "PAID"
+)
+
+func (p Period) Window(now time.Time) (time.Time, time.Time) {
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	if p == Weekly {
+		end = end.AddDate(0, 0, -((int(end.Weekday()) + 6) % 7))
+
+		return end.AddDate(0, 0, -7), end
+	}
+
+	return end.AddDate(0, 0, -1), end
+}
@@ -0,0 +1,10 @@
+// Package userrole defines the roles a user can hold
+package userrole
+
+type Role string
+
+const (
+	Admin   Role = "ADMIN"
+	Canteen Role = "CANTEEN"
+	User    Role = "USER"
+)
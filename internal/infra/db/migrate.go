@@ -13,8 +13,31 @@ func Migrate(db *gorm.DB) {
 		entity.UserDetail{},
 		entity.Canteen{},
 		entity.Menu{},
+		entity.BundleItem{},
 		entity.Order{},
+		entity.OrderItem{},
+		entity.OrderStatusHistory{},
 		entity.Payment{},
+		entity.PaymentNotification{},
+		entity.Refund{},
+		entity.ReconciliationReport{},
+		entity.ReconciliationEntry{},
+		entity.Wallet{},
+		entity.LedgerEntry{},
+		entity.Payout{},
+		entity.PayoutItem{},
+		entity.PayoutAdjustment{},
+		entity.Voucher{},
+		entity.VoucherRedemption{},
+		entity.Promotion{},
+		entity.MenuAvailability{},
+		entity.MenuCategory{},
+		entity.ModifierGroup{},
+		entity.ModifierOption{},
+		entity.OrderItemOption{},
+		entity.OrderItemComponent{},
+		entity.LoyaltyAccount{},
+		entity.LoyaltyEntry{},
 		entity.Feedback{},
 	)
 	if err != nil {
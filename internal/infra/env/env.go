@@ -17,6 +17,7 @@ type Env struct {
 	PasswordChangeExpiryMinutes       int    `env:"PASSWORD_CHANGE_EXPIRY_MINUTES"`
 	PasswordChangeCodeRetrySeconds    int    `env:"PASSWORD_CHANGE_CODE_RETRY_SECONDS"`
 	AppPort                           uint   `env:"APP_PORT"`
+	AppBaseURL                        string `env:"APP_BASE_URL"`
 	DBName                            string `env:"DB_NAME"`
 	DBUsername                        string `env:"DB_USERNAME"`
 	DBPassword                        string `env:"DB_PASSWORD"`
@@ -30,7 +31,29 @@ type Env struct {
 	RedisExpiration                   int    `env:"REDIS_EXPIRATION"`
 	JWTSecretKey                      string `env:"JWT_SECRET_KEY"`
 	JWTExpiredDays                    uint   `env:"JWT_EXPIRED_DAYS"`
+	PaymentProvider                   string `env:"PAYMENT_PROVIDER"`
 	MidtransServerKey                 string `env:"MIDTRANS_SERVER_KEY"`
+	FakePaymentServerKey              string `env:"FAKE_PAYMENT_SERVER_KEY"`
+	OrderExpiryMinutes                int    `env:"ORDER_EXPIRY_MINUTES"`
+	OrderExpiryIntervalSeconds        int    `env:"ORDER_EXPIRY_INTERVAL_SECONDS"`
+	ReconciliationIntervalMinutes     int    `env:"RECONCILIATION_INTERVAL_MINUTES"`
+	PlatformCommissionBasisPoints     uint   `env:"PLATFORM_COMMISSION_BASIS_POINTS"`
+	PayoutPeriod                      string `env:"PAYOUT_PERIOD"`
+	PayoutIntervalMinutes             int    `env:"PAYOUT_INTERVAL_MINUTES"`
+	LoyaltyEarnBasisPoints            uint   `env:"LOYALTY_EARN_BASIS_POINTS"`
+	LoyaltyExpiryDays                 int    `env:"LOYALTY_EXPIRY_DAYS"`
+	LoyaltyExpiryIntervalMinutes      int    `env:"LOYALTY_EXPIRY_INTERVAL_MINUTES"`
+	MenuCacheSeconds                  int    `env:"MENU_CACHE_SECONDS"`
+	StockResetIntervalMinutes         int    `env:"STOCK_RESET_INTERVAL_MINUTES"`
+	RefundRetryIntervalMinutes        int    `env:"REFUND_RETRY_INTERVAL_MINUTES"`
+	StorageProvider                   string `env:"STORAGE_PROVIDER"`
+	StorageLocalPath                  string `env:"STORAGE_LOCAL_PATH"`
+	StorageS3Endpoint                 string `env:"STORAGE_S3_ENDPOINT"`
+	StorageS3Region                   string `env:"STORAGE_S3_REGION"`
+	StorageS3Bucket                   string `env:"STORAGE_S3_BUCKET"`
+	StorageS3AccessKey                string `env:"STORAGE_S3_ACCESS_KEY"`
+	StorageS3SecretKey                string `env:"STORAGE_S3_SECRET_KEY"`
+	StorageS3PublicURL                string `env:"STORAGE_S3_PUBLIC_URL"`
 }
 
 func New() *Env {
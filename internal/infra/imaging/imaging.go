@@ -0,0 +1,126 @@
+// Package imaging validates uploaded images and resizes them into the variants served to clients
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+	"slices"
+)
+
+const (
+	FullSize      = 1200
+	ThumbnailSize = 320
+	maxPixels     = 24_000_000
+	quality       = 85
+)
+
+var (
+	ErrUnsupportedImage = errors.New("unsupported image type")
+	ErrImageTooLarge    = errors.New("image dimensions too large")
+)
+
+var contentTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+type Variants struct {
+	Full      []byte
+	Thumbnail []byte
+}
+
+func Process(data []byte) (Variants, error) {
+	if !slices.Contains(contentTypes, http.DetectContentType(data)) {
+		return Variants{}, ErrUnsupportedImage
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Variants{}, ErrUnsupportedImage
+	}
+
+	if config.Width*config.Height > maxPixels {
+		return Variants{}, ErrImageTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Variants{}, ErrUnsupportedImage
+	}
+
+	flat := image.NewRGBA(image.Rect(0, 0, src.Bounds().Dx(), src.Bounds().Dy()))
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, src.Bounds().Min, draw.Over)
+
+	full, err := encode(resize(flat, FullSize))
+	if err != nil {
+		return Variants{}, err
+	}
+
+	thumbnail, err := encode(resize(flat, ThumbnailSize))
+	if err != nil {
+		return Variants{}, err
+	}
+
+	return Variants{
+		Full:      full,
+		Thumbnail: thumbnail,
+	}, nil
+}
+
+func encode(img image.Image) ([]byte, error) {
+	var out bytes.Buffer
+
+	err := jpeg.Encode(&out, img, &jpeg.Options{Quality: quality})
+
+	return out.Bytes(), err
+}
+
+func resize(src *image.RGBA, size int) *image.RGBA {
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+	if width <= size && height <= size {
+		return src
+	}
+
+	newWidth, newHeight := size, max(height*size/width, 1)
+	if height > width {
+		newWidth, newHeight = max(width*size/height, 1), size
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, newWidth, newHeight))
+
+	for y := range newHeight {
+		y0, y1 := y*height/newHeight, max((y+1)*height/newHeight, y*height/newHeight+1)
+
+		for x := range newWidth {
+			x0, x1 := x*width/newWidth, max((x+1)*width/newWidth, x*width/newWidth+1)
+
+			var r, g, b, a, count uint64
+
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+
+				for sx := x0; sx < x1; sx++ {
+					pixel := row[sx*4 : sx*4+4]
+					r += uint64(pixel[0])
+					g += uint64(pixel[1])
+					b += uint64(pixel[2])
+					a += uint64(pixel[3])
+					count++
+				}
+			}
+
+			offset := y*dst.Stride + x*4
+			dst.Pix[offset] = uint8(r / count)
+			dst.Pix[offset+1] = uint8(g / count)
+			dst.Pix[offset+2] = uint8(b / count)
+			dst.Pix[offset+3] = uint8(a / count)
+		}
+	}
+
+	return dst
+}
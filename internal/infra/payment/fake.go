@@ -0,0 +1,228 @@
+package payment
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+var fakeTransactionStatuses = []string{"settlement", "pending", "deny", "cancel", "expire"}
+
+var fakePage = template.Must(template.New("fake").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Fake Payment</title></head>
+<body>
+<h1>Fake Payment</h1>
+<p>Order ID: {{.Status.OrderID}}</p>
+<p>Gross Amount: {{.Status.GrossAmount}}</p>
+<p>Transaction Status: {{.Status.TransactionStatus}}</p>
+<form method="post">
+{{range .Statuses}}<button type="submit" name="transaction_status" value="{{.}}">{{.}}</button>
+{{end}}</form>
+</body>
+</html>
+`))
+
+type Fake struct {
+	serverKey    string
+	baseURL      string
+	client       *http.Client
+	mutex        sync.Mutex
+	transactions map[string]dto.PaymentStatus
+}
+
+func NewFake(env *env.Env, router fiber.Router) *Fake {
+	fake := Fake{
+		serverKey:    env.FakePaymentServerKey,
+		baseURL:      env.AppBaseURL,
+		client:       &http.Client{Timeout: 10 * time.Second},
+		transactions: make(map[string]dto.PaymentStatus),
+	}
+
+	router.Get("/payment/fake/:id", fake.Page)
+	router.Post("/payment/fake/:id", fake.Pay)
+
+	return &fake
+}
+
+func (f *Fake) CreatePayment(createMidtransOrder dto.CreateMidtransOrder) (dto.ResponseMidtransOrder, error) {
+	orderID := createMidtransOrder.TransactionDetails.OrderID
+
+	if len(createMidtransOrder.ItemDetails) > 0 {
+		var itemTotal int64
+
+		for _, item := range createMidtransOrder.ItemDetails {
+			itemTotal += item.Price * int64(item.Quantity)
+		}
+
+		if itemTotal != int64(createMidtransOrder.TransactionDetails.GrossAmount) {
+			return dto.ResponseMidtransOrder{}, fiber.NewError(
+				http.StatusBadRequest,
+				"item details do not add up to gross amount",
+			)
+		}
+	}
+
+	f.mutex.Lock()
+	f.transactions[orderID] = dto.PaymentStatus{
+		OrderID:           orderID,
+		TransactionID:     uuid.NewString(),
+		TransactionStatus: "pending",
+		GrossAmount:       fmt.Sprintf("%d.00", createMidtransOrder.TransactionDetails.GrossAmount),
+	}
+	f.mutex.Unlock()
+
+	return dto.ResponseMidtransOrder{
+		Token:       uuid.NewString(),
+		RedirectURL: fmt.Sprintf("%s/api/v1/payment/fake/%s", f.baseURL, orderID),
+	}, nil
+}
+
+func (f *Fake) GetPaymentStatus(orderID string) (dto.PaymentStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	status, ok := f.transactions[orderID]
+	if !ok {
+		return dto.PaymentStatus{}, ErrTransactionNotFound
+	}
+
+	return status, nil
+}
+
+func (f *Fake) CancelPayment(orderID string) error {
+	_, err := f.setStatus(orderID, "cancel")
+
+	return err
+}
+
+func (f *Fake) RefundPayment(refundPayment dto.RefundPayment) error {
+	_, err := f.setStatus(refundPayment.OrderID, "refund")
+
+	return err
+}
+
+func (f *Fake) VerifyNotification(verifyPayment dto.VerifyPayment) error {
+	signature := Signature(
+		verifyPayment.OrderID,
+		verifyPayment.StatusCode,
+		verifyPayment.GrossAmount,
+		f.serverKey,
+	)
+
+	if signature != verifyPayment.SignatureKey {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"payment could not be verified",
+		)
+	}
+
+	return nil
+}
+
+func (f *Fake) Notify(orderID string, transactionStatus string) error {
+	status, err := f.setStatus(orderID, transactionStatus)
+	if err != nil {
+		return err
+	}
+
+	statusCode := "202"
+
+	switch transactionStatus {
+	case "settlement", "capture", "refund":
+		statusCode = "200"
+	case "pending":
+		statusCode = "201"
+	}
+
+	verifyPayment := dto.VerifyPayment{
+		OrderID:           status.OrderID,
+		TransactionStatus: status.TransactionStatus,
+		TransactionID:     status.TransactionID,
+		StatusCode:        statusCode,
+		GrossAmount:       status.GrossAmount,
+		SignatureKey:      Signature(status.OrderID, statusCode, status.GrossAmount, f.serverKey),
+	}
+
+	body, err := json.Marshal(verifyPayment)
+	if err != nil {
+		return err
+	}
+
+	res, err := f.client.Post(
+		fmt.Sprintf("%s/api/v1/canteen/payment/verification", f.baseURL),
+		fiber.MIMEApplicationJSON,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fiber.NewError(
+			http.StatusBadGateway,
+			fmt.Sprintf("notification rejected with status %d", res.StatusCode),
+		)
+	}
+
+	return nil
+}
+
+func (f *Fake) Page(ctx *fiber.Ctx) error {
+	status, err := f.GetPaymentStatus(ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	ctx.Type("html")
+
+	return fakePage.Execute(ctx, fiber.Map{
+		"Status":   status,
+		"Statuses": fakeTransactionStatuses,
+	})
+}
+
+func (f *Fake) Pay(ctx *fiber.Ctx) error {
+	orderID := ctx.Params("id")
+	transactionStatus := ctx.FormValue("transaction_status")
+
+	if !slices.Contains(fakeTransactionStatuses, transactionStatus) {
+		return fiber.NewError(
+			http.StatusBadRequest,
+			"invalid transaction status",
+		)
+	}
+
+	err := f.Notify(orderID, transactionStatus)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Redirect(fmt.Sprintf("%s/api/v1/payment/fake/%s", f.baseURL, orderID))
+}
+
+func (f *Fake) setStatus(orderID string, transactionStatus string) (dto.PaymentStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	status, ok := f.transactions[orderID]
+	if !ok {
+		return dto.PaymentStatus{}, ErrTransactionNotFound
+	}
+
+	status.TransactionStatus = transactionStatus
+	f.transactions[orderID] = status
+
+	return status, nil
+}
@@ -0,0 +1,136 @@
+package payment
+
+import (
+	"net/http"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
+	"github.com/gofiber/fiber/v2"
+	"github.com/midtrans/midtrans-go"
+	"github.com/midtrans/midtrans-go/coreapi"
+	"github.com/midtrans/midtrans-go/snap"
+)
+
+type Midtrans struct {
+	Client     *snap.Client
+	CoreClient *coreapi.Client
+	serverKey  string
+}
+
+func NewMidtrans(env *env.Env) *Midtrans {
+	var client snap.Client
+	client.New(env.MidtransServerKey, midtrans.Sandbox)
+
+	var coreClient coreapi.Client
+	coreClient.New(env.MidtransServerKey, midtrans.Sandbox)
+
+	Midtrans := Midtrans{
+		Client:     &client,
+		CoreClient: &coreClient,
+		serverKey:  env.MidtransServerKey,
+	}
+
+	return &Midtrans
+}
+
+func (m *Midtrans) GenerateSnapRequest(createMidtransOrder dto.CreateMidtransOrder) *snap.Request {
+	snapRequest := snap.Request{
+		TransactionDetails: midtrans.TransactionDetails{
+			OrderID:  createMidtransOrder.TransactionDetails.OrderID,
+			GrossAmt: int64(createMidtransOrder.TransactionDetails.GrossAmount),
+		},
+		CustomerDetail: &midtrans.CustomerDetails{
+			FName: createMidtransOrder.CustomerDetail.FirstName,
+			Email: createMidtransOrder.CustomerDetail.Email,
+		},
+	}
+
+	if len(createMidtransOrder.ItemDetails) > 0 {
+		items := make([]midtrans.ItemDetails, len(createMidtransOrder.ItemDetails))
+
+		for i, item := range createMidtransOrder.ItemDetails {
+			items[i] = midtrans.ItemDetails{
+				ID:    item.ID,
+				Name:  item.Name,
+				Price: item.Price,
+				Qty:   item.Quantity,
+			}
+		}
+
+		snapRequest.Items = &items
+	}
+
+	return &snapRequest
+}
+
+func (m *Midtrans) CreatePayment(createMidtransOrder dto.CreateMidtransOrder) (dto.ResponseMidtransOrder, error) {
+	snapRequest := m.GenerateSnapRequest(createMidtransOrder)
+
+	res, err := m.Client.CreateTransaction(snapRequest)
+	if err != nil {
+		return dto.ResponseMidtransOrder{}, err
+	}
+
+	return dto.ResponseMidtransOrder{
+		Token:       res.Token,
+		RedirectURL: res.RedirectURL,
+	}, nil
+}
+
+func (m *Midtrans) GetPaymentStatus(orderID string) (dto.PaymentStatus, error) {
+	res, err := m.CoreClient.CheckTransaction(orderID)
+	if err != nil && err.StatusCode == http.StatusNotFound {
+		return dto.PaymentStatus{}, ErrTransactionNotFound
+	} else if err != nil {
+		return dto.PaymentStatus{}, err
+	}
+
+	return dto.PaymentStatus{
+		OrderID:           res.OrderID,
+		TransactionID:     res.TransactionID,
+		TransactionStatus: res.TransactionStatus,
+		GrossAmount:       res.GrossAmount,
+	}, nil
+}
+
+func (m *Midtrans) CancelPayment(orderID string) error {
+	_, err := m.CoreClient.CancelTransaction(orderID)
+	if err != nil && err.StatusCode == http.StatusNotFound {
+		return ErrTransactionNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Midtrans) RefundPayment(refundPayment dto.RefundPayment) error {
+	_, err := m.CoreClient.RefundTransaction(refundPayment.OrderID, &coreapi.RefundReq{
+		RefundKey: refundPayment.RefundKey,
+		Amount:    int64(refundPayment.Amount),
+		Reason:    refundPayment.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Midtrans) VerifyNotification(verifyPayment dto.VerifyPayment) error {
+	signature := Signature(
+		verifyPayment.OrderID,
+		verifyPayment.StatusCode,
+		verifyPayment.GrossAmount,
+		m.serverKey,
+	)
+
+	if signature != verifyPayment.SignatureKey {
+		return fiber.NewError(
+			http.StatusUnauthorized,
+			"payment could not be verified",
+		)
+	}
+
+	return nil
+}
@@ -2,49 +2,44 @@
 package payment
 
 import (
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+
 	"github.com/SyafaHadyan/freepass-2026/internal/domain/dto"
 	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
-	"github.com/midtrans/midtrans-go"
-	"github.com/midtrans/midtrans-go/snap"
+	"github.com/gofiber/fiber/v2"
 )
 
-type PaymentItf interface {
-	GenerateSnapRequest(createMidtransOrder dto.CreateMidtransOrder) *snap.Request
-	CreatePayment(createMidtransOrder dto.CreateMidtransOrder) (*snap.Response, error)
-}
+var ErrTransactionNotFound = fiber.NewError(
+	http.StatusNotFound,
+	"transaction not found",
+)
 
-type Payment struct {
-	Client *snap.Client
+type PaymentItf interface {
+	CreatePayment(createMidtransOrder dto.CreateMidtransOrder) (dto.ResponseMidtransOrder, error)
+	GetPaymentStatus(orderID string) (dto.PaymentStatus, error)
+	CancelPayment(orderID string) error
+	RefundPayment(refundPayment dto.RefundPayment) error
+	VerifyNotification(verifyPayment dto.VerifyPayment) error
 }
 
-func New(env *env.Env) *Payment {
-	var client snap.Client
-	client.New(env.MidtransServerKey, midtrans.Sandbox)
+func New(env *env.Env, router fiber.Router) PaymentItf {
+	switch env.PaymentProvider {
+	case "fake":
+		log.Println("using fake payment provider")
 
-	Payment := Payment{
-		Client: &client,
+		return NewFake(env, router)
+	default:
+		return NewMidtrans(env)
 	}
-
-	return &Payment
 }
 
-func (p *Payment) GenerateSnapRequest(createMidtransOrder dto.CreateMidtransOrder) *snap.Request {
-	return &snap.Request{
-		TransactionDetails: midtrans.TransactionDetails{
-			OrderID:  createMidtransOrder.TransactionDetails.OrderID,
-			GrossAmt: int64(createMidtransOrder.TransactionDetails.GrossAmount),
-		},
-		CustomerDetail: &midtrans.CustomerDetails{
-			FName: createMidtransOrder.CustomerDetail.FirstName,
-			Email: createMidtransOrder.CustomerDetail.Email,
-		},
-	}
-}
-
-func (p *Payment) CreatePayment(createMidtransOrder dto.CreateMidtransOrder) (*snap.Response, error) {
-	snapRequest := p.GenerateSnapRequest(createMidtransOrder)
-
-	res, err := p.Client.CreateTransaction(snapRequest)
+func Signature(orderID string, statusCode string, grossAmount string, serverKey string) string {
+	hash := sha512.New()
+	hash.Write(fmt.Appendf(nil, "%s%s%s%s", orderID, statusCode, grossAmount, serverKey))
 
-	return res, err
+	return hex.EncodeToString(hash.Sum(nil))
 }
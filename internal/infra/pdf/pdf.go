@@ -0,0 +1,138 @@
+// Package pdf renders simple text documents, such as receipts, into PDF files without external dependencies
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth  = 595.0
+	pageHeight = 842.0
+	margin     = 50.0
+
+	charWidth  = 0.6
+	lineHeight = 1.4
+)
+
+type DocumentItf interface {
+	Text(size float64, text string)
+	Row(size float64, left string, right string)
+	Gap(height float64)
+	Bytes() []byte
+}
+
+type Document struct {
+	pages []bytes.Buffer
+	y     float64
+}
+
+func New() *Document {
+	d := &Document{}
+	d.addPage()
+
+	return d
+}
+
+func (d *Document) Text(size float64, text string) {
+	d.advance(size)
+	d.write(margin, size, text)
+}
+
+func (d *Document) Row(size float64, left string, right string) {
+	d.advance(size)
+	d.write(margin, size, left)
+	d.write(pageWidth-margin-float64(len(encode(right)))*size*charWidth, size, right)
+}
+
+func (d *Document) Gap(height float64) {
+	d.y -= height
+}
+
+func (d *Document) Bytes() []byte {
+	var out bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n")
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+i*2)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range d.pages {
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %g %g] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pageWidth,
+			pageHeight,
+			5+i*2,
+		))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", page.Len(), page.Bytes()))
+	}
+
+	xref := out.Len()
+
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+func (d *Document) addPage() {
+	d.pages = append(d.pages, bytes.Buffer{})
+	d.y = pageHeight - margin
+}
+
+func (d *Document) advance(size float64) {
+	if d.y-size*lineHeight < margin {
+		d.addPage()
+	}
+
+	d.y -= size * lineHeight
+}
+
+func (d *Document) write(x float64, size float64, text string) {
+	fmt.Fprintf(&d.pages[len(d.pages)-1], "BT /F1 %g Tf %.2f %.2f Td (%s) Tj ET\n", size, x, d.y, escape(encode(text)))
+}
+
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+
+	for _, r := range text {
+		if r > 0xff || (r < 0x20 && r != '\t') {
+			r = '?'
+		}
+
+		out = append(out, byte(r))
+	}
+
+	return out
+}
+
+func escape(text []byte) string {
+	var out strings.Builder
+
+	for _, c := range text {
+		if c == '\\' || c == '(' || c == ')' {
+			out.WriteByte('\\')
+		}
+
+		out.WriteByte(c)
+	}
+
+	return out.String()
+}
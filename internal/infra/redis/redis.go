@@ -13,7 +13,9 @@ import (
 
 type RedisItf interface {
 	Set(key string, value string)
+	SetWithTTL(key string, value string, ttl time.Duration)
 	Get(key string) (string, error)
+	Incr(key string)
 }
 
 type Redis struct {
@@ -69,8 +71,20 @@ func (r *Redis) Set(key string, value string) {
 	r.Client.Set(ctx, key, value, time.Duration(r.expiration))
 }
 
+func (r *Redis) SetWithTTL(key string, value string, ttl time.Duration) {
+	ctx := context.Background()
+
+	r.Client.Set(ctx, key, value, ttl)
+}
+
 func (r *Redis) Get(key string) (string, error) {
 	value, err := r.Client.Get(context.Background(), key).Result()
 
 	return value, err
 }
+
+func (r *Redis) Incr(key string) {
+	ctx := context.Background()
+
+	r.Client.Incr(ctx, key)
+}
@@ -0,0 +1,82 @@
+// Package scheduler runs background jobs periodically until the service shuts down
+package scheduler
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+type SchedulerItf interface {
+	Add(name string, interval time.Duration, run func() error)
+	Start()
+	Stop()
+}
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func() error
+}
+
+type Scheduler struct {
+	jobs []Job
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func New() *Scheduler {
+	return &Scheduler{
+		stop: make(chan struct{}),
+	}
+}
+
+func (s *Scheduler) Add(name string, interval time.Duration, run func() error) {
+	if interval <= 0 {
+		log.Printf("job %s disabled", name)
+
+		return
+	}
+
+	s.jobs = append(s.jobs, Job{
+		Name:     name,
+		Interval: interval,
+		Run:      run,
+	})
+}
+
+func (s *Scheduler) Start() {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+
+		go s.run(job)
+	}
+
+	log.Printf("started %d background jobs", len(s.jobs))
+}
+
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+
+	log.Println("background jobs stopped")
+}
+
+func (s *Scheduler) run(job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			err := job.Run()
+			if err != nil {
+				log.Printf("job %s failed: %v", job.Name, err)
+			}
+		}
+	}
+}
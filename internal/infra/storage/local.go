@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Local struct {
+	path    string
+	baseURL string
+}
+
+func NewLocal(env *env.Env, router fiber.Router) *Local {
+	err := os.MkdirAll(env.StorageLocalPath, 0o755)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	if router != nil {
+		router.Static("/storage", env.StorageLocalPath)
+	}
+
+	return &Local{
+		path:    env.StorageLocalPath,
+		baseURL: env.AppBaseURL,
+	}
+}
+
+func (l *Local) Put(key string, contentType string, data []byte) error {
+	path := filepath.Join(l.path, filepath.FromSlash(key))
+
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if err != nil {
+		file.Close()
+
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(file.Name(), 0o644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (l *Local) Delete(key string) error {
+	err := os.Remove(filepath.Join(l.path, filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (l *Local) URL(key string) string {
+	return fmt.Sprintf("%s/api/v1/storage/%s", l.baseURL, key)
+}
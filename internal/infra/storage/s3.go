@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
+)
+
+type S3 struct {
+	endpoint  string
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	publicURL string
+	client    *http.Client
+}
+
+func NewS3(env *env.Env) *S3 {
+	endpoint := strings.TrimSuffix(env.StorageS3Endpoint, "/")
+
+	publicURL := strings.TrimSuffix(env.StorageS3PublicURL, "/")
+	if publicURL == "" {
+		publicURL = fmt.Sprintf("%s/%s", endpoint, env.StorageS3Bucket)
+	}
+
+	return &S3{
+		endpoint:  endpoint,
+		region:    env.StorageS3Region,
+		bucket:    env.StorageS3Bucket,
+		accessKey: env.StorageS3AccessKey,
+		secretKey: env.StorageS3SecretKey,
+		publicURL: publicURL,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (s *S3) Put(key string, contentType string, data []byte) error {
+	return s.do(http.MethodPut, key, contentType, data)
+}
+
+func (s *S3) Delete(key string) error {
+	return s.do(http.MethodDelete, key, "", nil)
+}
+
+func (s *S3) URL(key string) string {
+	return fmt.Sprintf("%s/%s", s.publicURL, key)
+}
+
+func (s *S3) do(method string, key string, contentType string, data []byte) error {
+	req, err := http.NewRequest(
+		method,
+		fmt.Sprintf("%s/%s/%s", s.endpoint, s.bucket, key),
+		bytes.NewReader(data),
+	)
+	if err != nil {
+		return err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	s.sign(req, data, time.Now().UTC())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+		return fmt.Errorf("storage: %s %s: %s %s", method, key, res.Status, body)
+	}
+
+	return nil
+}
+
+func (s *S3) sign(req *http.Request, data []byte, now time.Time) {
+	payloadHash := sha256Hex(data)
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.region)
+
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	values := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+	}
+
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		values["content-type"] = contentType
+	}
+
+	headers := slices.Sorted(maps.Keys(values))
+
+	var canonicalHeaders strings.Builder
+
+	for _, header := range headers {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", header, strings.TrimSpace(values[header]))
+	}
+
+	signedHeaders := strings.Join(headers, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey,
+		scope,
+		signedHeaders,
+		hex.EncodeToString(hmacSHA256(key, stringToSign)),
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	hash := hmac.New(sha256.New, key)
+	hash.Write([]byte(data))
+
+	return hash.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:])
+}
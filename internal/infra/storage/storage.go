@@ -0,0 +1,26 @@
+// Package storage stores uploaded files on the local disk or an S3 compatible object storage and builds their URLs
+package storage
+
+import (
+	"log"
+
+	"github.com/SyafaHadyan/freepass-2026/internal/infra/env"
+	"github.com/gofiber/fiber/v2"
+)
+
+type StorageItf interface {
+	Put(key string, contentType string, data []byte) error
+	Delete(key string) error
+	URL(key string) string
+}
+
+func New(env *env.Env, router fiber.Router) StorageItf {
+	switch env.StorageProvider {
+	case "s3":
+		log.Println("using s3 storage")
+
+		return NewS3(env)
+	default:
+		return NewLocal(env, router)
+	}
+}
@@ -13,6 +13,7 @@ printf "PASSWORD_CHANGE_EXPIRY_MINUTES=%s\n" $PASSWORD_CHANGE_EXPIRY_MINUTES >>.
 printf "PASSWORD_CHANGE_CODE_RETRY_SECONDS=%s\n" $PASSWORD_CHANGE_CODE_RETRY_SECONDS >>.env
 
 printf "APP_PORT=%s\n" $APP_PORT >>.env
+printf "APP_BASE_URL=%s\n" $APP_BASE_URL >>.env
 
 printf "DB_NAME=%s\n" $DB_NAME >>.env
 printf "DB_USERNAME=%s\n" $DB_USERNAME >>.env
@@ -30,7 +31,31 @@ printf "REDIS_EXPIRATION=%s\n" $REDIS_EXPIRATION >>.env
 printf "JWT_SECRET_KEY=%s\n" $JWT_SECRET_KEY >>.env
 printf "JWT_EXPIRED_DAYS=%s\n" $JWT_EXPIRED_DAYS >>.env
 
+printf "PAYMENT_PROVIDER=%s\n" $PAYMENT_PROVIDER >>.env
 printf "MIDTRANS_SERVER_KEY=%s\n" $MIDTRANS_SERVER_KEY >>.env
+printf "FAKE_PAYMENT_SERVER_KEY=%s\n" $FAKE_PAYMENT_SERVER_KEY >>.env
+
+printf "ORDER_EXPIRY_MINUTES=%s\n" $ORDER_EXPIRY_MINUTES >>.env
+printf "ORDER_EXPIRY_INTERVAL_SECONDS=%s\n" $ORDER_EXPIRY_INTERVAL_SECONDS >>.env
+printf "RECONCILIATION_INTERVAL_MINUTES=%s\n" $RECONCILIATION_INTERVAL_MINUTES >>.env
+printf "PLATFORM_COMMISSION_BASIS_POINTS=%s\n" $PLATFORM_COMMISSION_BASIS_POINTS >>.env
+printf "PAYOUT_PERIOD=%s\n" $PAYOUT_PERIOD >>.env
+printf "PAYOUT_INTERVAL_MINUTES=%s\n" $PAYOUT_INTERVAL_MINUTES >>.env
+printf "LOYALTY_EARN_BASIS_POINTS=%s\n" $LOYALTY_EARN_BASIS_POINTS >>.env
+printf "LOYALTY_EXPIRY_DAYS=%s\n" $LOYALTY_EXPIRY_DAYS >>.env
+printf "LOYALTY_EXPIRY_INTERVAL_MINUTES=%s\n" $LOYALTY_EXPIRY_INTERVAL_MINUTES >>.env
+printf "MENU_CACHE_SECONDS=%s\n" $MENU_CACHE_SECONDS >>.env
+printf "STOCK_RESET_INTERVAL_MINUTES=%s\n" $STOCK_RESET_INTERVAL_MINUTES >>.env
+printf "REFUND_RETRY_INTERVAL_MINUTES=%s\n" $REFUND_RETRY_INTERVAL_MINUTES >>.env
+
+printf "STORAGE_PROVIDER=%s\n" $STORAGE_PROVIDER >>.env
+printf "STORAGE_LOCAL_PATH=%s\n" $STORAGE_LOCAL_PATH >>.env
+printf "STORAGE_S3_ENDPOINT=%s\n" $STORAGE_S3_ENDPOINT >>.env
+printf "STORAGE_S3_REGION=%s\n" $STORAGE_S3_REGION >>.env
+printf "STORAGE_S3_BUCKET=%s\n" $STORAGE_S3_BUCKET >>.env
+printf "STORAGE_S3_ACCESS_KEY=%s\n" $STORAGE_S3_ACCESS_KEY >>.env
+printf "STORAGE_S3_SECRET_KEY=%s\n" $STORAGE_S3_SECRET_KEY >>.env
+printf "STORAGE_S3_PUBLIC_URL=%s\n" $STORAGE_S3_PUBLIC_URL >>.env
 
 printf "%s\n" "done setting up environment variables"
 printf "%s\n" "starting application"